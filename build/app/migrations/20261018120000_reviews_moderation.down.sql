@@ -0,0 +1,17 @@
+begin;
+
+drop index if exists moving.idx_moving_reviews_status;
+drop index if exists moving.idx_moving_reviews_order_id;
+
+alter table moving.reviews
+    drop column if exists moderated_at,
+    drop column if exists order_id,
+    drop column if exists status,
+    drop column if exists source;
+
+alter table moving.orders
+    drop column if exists review_secret;
+
+drop type if exists moving.review_status_enum;
+
+end;
@@ -0,0 +1,24 @@
+begin;
+
+create type moving.review_status_enum as enum (
+    'pending',
+    'approved',
+    'rejected'
+);
+
+alter table moving.orders
+    add column if not exists review_secret uuid not null default gen_random_uuid();
+
+alter table moving.reviews
+    add column if not exists source       varchar(20)               not null default 'yelp',
+    add column if not exists status       moving.review_status_enum not null default 'approved',
+    add column if not exists order_id     int                       references moving.orders (id),
+    add column if not exists moderated_at timestamp;
+
+grant insert, update on table    moving.reviews        to "moving-r";
+grant usage          on sequence moving.reviews_id_seq to "moving-r";
+
+create unique index if not exists idx_moving_reviews_order_id on moving.reviews (order_id);
+create index        if not exists idx_moving_reviews_status   on moving.reviews (status);
+
+end;
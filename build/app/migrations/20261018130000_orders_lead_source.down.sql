@@ -0,0 +1,15 @@
+begin;
+
+drop index if exists moving.idx_moving_orders_source;
+
+alter table moving.orders
+    drop column if exists landing_page,
+    drop column if exists referrer,
+    drop column if exists utm_campaign,
+    drop column if exists utm_medium,
+    drop column if exists utm_source,
+    drop column if exists source;
+
+drop type if exists moving.lead_source_enum;
+
+end;
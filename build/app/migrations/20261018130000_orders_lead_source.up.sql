@@ -0,0 +1,24 @@
+begin;
+
+create type moving.lead_source_enum as enum (
+    'unknown',
+    'website',
+    'yelp',
+    'google_ads',
+    'facebook_ads',
+    'referral',
+    'phone',
+    'other'
+);
+
+alter table moving.orders
+    add column if not exists source       moving.lead_source_enum not null default 'unknown',
+    add column if not exists utm_source   varchar(255),
+    add column if not exists utm_medium   varchar(255),
+    add column if not exists utm_campaign varchar(255),
+    add column if not exists referrer     varchar(2048),
+    add column if not exists landing_page varchar(2048);
+
+create index if not exists idx_moving_orders_source on moving.orders (source);
+
+end;
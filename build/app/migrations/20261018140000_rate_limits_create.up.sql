@@ -0,0 +1,13 @@
+begin;
+
+create table if not exists moving.rate_limits (
+    key          varchar(255) primary key,
+    window_start timestamp    not null,
+    hits         int          not null
+);
+
+grant insert, select, update, delete on table moving.rate_limits to "moving-r";
+
+create index if not exists idx_moving_rate_limits_window_start on moving.rate_limits (window_start);
+
+end;
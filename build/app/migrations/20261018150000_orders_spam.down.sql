@@ -0,0 +1,8 @@
+begin;
+
+drop index if exists moving.idx_moving_orders_spam_reason;
+
+alter table moving.orders
+    drop column if exists spam_reason;
+
+end;
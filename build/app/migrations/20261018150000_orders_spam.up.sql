@@ -0,0 +1,8 @@
+begin;
+
+alter table moving.orders
+    add column if not exists spam_reason varchar(50);
+
+create index if not exists idx_moving_orders_spam_reason on moving.orders (spam_reason) where spam_reason is not null;
+
+end;
@@ -0,0 +1,17 @@
+begin;
+
+create table if not exists moving.idempotency_keys (
+    key           varchar(255) not null,
+    method        varchar(255) not null,
+    request_hash  varchar(64)  not null,
+    response_type varchar(255),
+    response      bytea,
+    created_at    timestamp    not null,
+    primary key (key, method)
+);
+
+grant insert, select, update, delete on table moving.idempotency_keys to "moving-r";
+
+create index if not exists idx_moving_idempotency_keys_created_at on moving.idempotency_keys (created_at);
+
+end;
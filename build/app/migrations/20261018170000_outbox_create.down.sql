@@ -0,0 +1,7 @@
+begin;
+
+drop table if exists moving.outbox;
+
+drop type if exists moving.outbox_status_enum;
+
+end;
@@ -0,0 +1,29 @@
+begin;
+
+create type moving.outbox_status_enum as enum (
+    'pending',
+    'delivered',
+    'dead'
+);
+
+create table if not exists moving.outbox (
+    id              bigserial primary key,
+    destination     varchar(50)               not null,
+    event_type      varchar(50)               not null,
+    aggregate_id    bigint                    not null,
+    payload         jsonb                     not null,
+    status          moving.outbox_status_enum not null default 'pending',
+    attempts        int                       not null default 0,
+    next_attempt_at timestamp                 not null default now(),
+    last_error      text,
+    created_at      timestamp                 not null default now(),
+    delivered_at    timestamp
+);
+
+grant insert, select, update, delete on table    moving.outbox        to "moving-r";
+grant usage                          on sequence moving.outbox_id_seq to "moving-r";
+
+create index if not exists idx_moving_outbox_pending on moving.outbox (next_attempt_at) where status = 'pending';
+create index if not exists idx_moving_outbox_status  on moving.outbox (status, created_at);
+
+end;
@@ -0,0 +1,7 @@
+begin;
+
+drop table if exists moving.sms_messages;
+
+drop type if exists moving.sms_status_enum;
+
+end;
@@ -0,0 +1,30 @@
+begin;
+
+create type moving.sms_status_enum as enum (
+    'queued',
+    'sent',
+    'delivered',
+    'undelivered',
+    'failed'
+);
+
+create table if not exists moving.sms_messages (
+    id          bigserial primary key,
+    order_id    int                    not null references moving.orders (id),
+    kind        varchar(50)            not null,
+    phone       varchar(50)            not null,
+    body        text,
+    status      moving.sms_status_enum not null default 'queued',
+    provider_id varchar(100),
+    error       text,
+    created_at  timestamp              not null default now(),
+    updated_at  timestamp              not null default now()
+);
+
+grant insert, select, update on table    moving.sms_messages        to "moving-r";
+grant usage                  on sequence moving.sms_messages_id_seq to "moving-r";
+
+create unique index if not exists idx_moving_sms_messages_order_id_kind on moving.sms_messages (order_id, kind);
+create unique index if not exists idx_moving_sms_messages_provider_id   on moving.sms_messages (provider_id);
+
+end;
@@ -0,0 +1,8 @@
+begin;
+
+drop table if exists moving.webhook_deliveries;
+drop table if exists moving.webhook_subscriptions;
+
+drop type if exists moving.webhook_delivery_status_enum;
+
+end;
@@ -0,0 +1,46 @@
+begin;
+
+create type moving.webhook_delivery_status_enum as enum (
+    'pending',
+    'delivered',
+    'failed'
+);
+
+create table if not exists moving.webhook_subscriptions (
+    id          serial primary key,
+    url         text         not null,
+    event_types text[]       not null,
+    secret      varchar(100) not null,
+    created_at  timestamp    not null default now(),
+    deleted_at  timestamp
+);
+
+create table if not exists moving.webhook_deliveries (
+    id              bigserial primary key,
+    subscription_id int                                  not null references moving.webhook_subscriptions (id),
+    outbox_event_id bigint                               not null,
+    event_type      varchar(50)                          not null,
+    order_id        int                                  not null references moving.orders (id),
+    payload         jsonb                                not null,
+    status          moving.webhook_delivery_status_enum not null default 'pending',
+    attempts        int                                  not null default 0,
+    response_status int,
+    last_error      text,
+    next_attempt_at timestamp                            not null default now(),
+    created_at      timestamp                            not null default now(),
+    delivered_at    timestamp
+);
+
+grant insert, select, update on table    moving.webhook_subscriptions        to "moving-r";
+grant usage                  on sequence moving.webhook_subscriptions_id_seq to "moving-r";
+grant insert, select, update on table    moving.webhook_deliveries           to "moving-r";
+grant usage                  on sequence moving.webhook_deliveries_id_seq    to "moving-r";
+
+create unique index if not exists idx_moving_webhook_deliveries_event
+    on moving.webhook_deliveries (subscription_id, outbox_event_id, event_type);
+create index if not exists idx_moving_webhook_deliveries_pending
+    on moving.webhook_deliveries (next_attempt_at) where status = 'pending';
+create index if not exists idx_moving_webhook_deliveries_created_at
+    on moving.webhook_deliveries (created_at);
+
+end;
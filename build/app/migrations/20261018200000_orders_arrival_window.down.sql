@@ -0,0 +1,8 @@
+begin;
+
+alter table moving.orders
+    drop column if exists arrival_window;
+
+drop type if exists moving.arrival_window_enum;
+
+end;
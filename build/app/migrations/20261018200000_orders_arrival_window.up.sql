@@ -0,0 +1,13 @@
+begin;
+
+create type moving.arrival_window_enum as enum (
+    'unknown',
+    'morning',
+    'midday',
+    'afternoon'
+);
+
+alter table moving.orders
+    add column if not exists arrival_window moving.arrival_window_enum not null default 'unknown';
+
+end;
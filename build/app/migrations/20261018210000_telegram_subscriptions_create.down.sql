@@ -0,0 +1,6 @@
+begin;
+
+drop table if exists moving.telegram_subscriptions;
+drop table if exists moving.telegram_invites;
+
+end;
@@ -0,0 +1,28 @@
+begin;
+
+create table if not exists moving.telegram_invites (
+    id              serial primary key,
+    code            varchar(64) not null unique,
+    expires_at      timestamp   not null,
+    used_at         timestamp,
+    used_by_chat_id bigint,
+    created_at      timestamp   not null default now()
+);
+
+create table if not exists moving.telegram_subscriptions (
+    chat_id        bigint primary key,
+    title          varchar(255) not null default '',
+    new_orders     boolean      not null default true,
+    status_changes boolean      not null default true,
+    digests        boolean      not null default true,
+    invite_id      int references moving.telegram_invites (id),
+    created_at     timestamp    not null default now(),
+    updated_at     timestamp    not null default now(),
+    revoked_at     timestamp
+);
+
+grant insert, select, update on table    moving.telegram_invites        to "moving-r";
+grant usage                  on sequence moving.telegram_invites_id_seq to "moving-r";
+grant insert, select, update on table    moving.telegram_subscriptions  to "moving-r";
+
+end;
@@ -0,0 +1,5 @@
+begin;
+
+drop table if exists moving.telegram_digest_runs;
+
+end;
@@ -0,0 +1,12 @@
+begin;
+
+create table if not exists moving.telegram_digest_runs (
+    digest       varchar(16) not null,
+    scheduled_at timestamp   not null,
+    created_at   timestamp   not null default now(),
+    primary key (digest, scheduled_at)
+);
+
+grant insert, select on table moving.telegram_digest_runs to "moving-r";
+
+end;
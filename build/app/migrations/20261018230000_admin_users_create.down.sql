@@ -0,0 +1,8 @@
+begin;
+
+alter table moving.orders drop column if exists updated_by;
+
+drop table if exists moving.admin_refresh_tokens;
+drop table if exists moving.admin_users;
+
+end;
@@ -0,0 +1,34 @@
+begin;
+
+create table if not exists moving.admin_users (
+    id            serial primary key,
+    email         varchar(255) not null,
+    name          varchar(255) not null default '',
+    password_hash varchar(100) not null,
+    roles         text[]       not null check (roles <@ array ['dispatcher', 'manager', 'owner']),
+    created_at    timestamp    not null default now(),
+    updated_at    timestamp    not null default now(),
+    disabled_at   timestamp
+);
+
+create unique index if not exists admin_users_email_idx on moving.admin_users (lower(email));
+
+create table if not exists moving.admin_refresh_tokens (
+    id         serial primary key,
+    user_id    int         not null references moving.admin_users (id),
+    token_hash varchar(64) not null unique,
+    expires_at timestamp   not null,
+    created_at timestamp   not null default now(),
+    revoked_at timestamp
+);
+
+create index if not exists admin_refresh_tokens_user_id_idx on moving.admin_refresh_tokens (user_id);
+
+alter table moving.orders add column if not exists updated_by int references moving.admin_users (id);
+
+grant insert, select, update on table    moving.admin_users               to "moving-r";
+grant usage                  on sequence moving.admin_users_id_seq          to "moving-r";
+grant insert, select, update on table    moving.admin_refresh_tokens      to "moving-r";
+grant usage                  on sequence moving.admin_refresh_tokens_id_seq to "moving-r";
+
+end;
@@ -0,0 +1,19 @@
+begin;
+
+create table if not exists moving.api_keys (
+    id           serial primary key,
+    name         varchar(255) not null,
+    prefix       varchar(16)  not null,
+    key_hash     varchar(64)  not null unique,
+    scopes       text[]       not null,
+    expires_at   timestamp,
+    last_used_at timestamp,
+    created_by   int references moving.admin_users (id),
+    created_at   timestamp    not null default now(),
+    revoked_at   timestamp
+);
+
+grant insert, select, update on table    moving.api_keys        to "moving-r";
+grant usage                  on sequence moving.api_keys_id_seq to "moving-r";
+
+end;
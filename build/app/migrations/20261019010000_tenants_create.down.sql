@@ -0,0 +1,25 @@
+begin;
+
+drop index if exists moving.idx_moving_reviews_tenant_id_status;
+drop index if exists moving.idx_moving_orders_tenant_id_created_at;
+
+alter table moving.telegram_digest_runs
+    drop constraint if exists telegram_digest_runs_pkey;
+
+delete from moving.telegram_digest_runs
+where tenant_id <> (select id from moving.tenants where slug = 'default');
+
+alter table moving.telegram_digest_runs
+    add primary key (digest, scheduled_at);
+
+alter table moving.admin_users            drop column if exists tenant_id;
+alter table moving.api_keys               drop column if exists tenant_id;
+alter table moving.telegram_digest_runs   drop column if exists tenant_id;
+alter table moving.telegram_subscriptions drop column if exists tenant_id;
+alter table moving.telegram_invites       drop column if exists tenant_id;
+alter table moving.reviews                drop column if exists tenant_id;
+alter table moving.orders                 drop column if exists tenant_id;
+
+drop table if exists moving.tenants;
+
+end;
@@ -0,0 +1,51 @@
+begin;
+
+-- pricing is {"currency": "USD", "estimates": {"studio": {"from": 300, "to": 500}, ...}} by property size.
+create table if not exists moving.tenants (
+    id             serial primary key,
+    slug           varchar(64)  not null unique,
+    name           varchar(255) not null,
+    hosts          text[]       not null default '{}',
+    brand_phone    varchar(32)  not null default '',
+    brand_email    varchar(255) not null default '',
+    brand_site_url varchar(255) not null default '',
+    pricing        jsonb        not null default '{}',
+    created_at     timestamp    not null default now(),
+    disabled_at    timestamp
+);
+
+insert into moving.tenants (slug, name) values ('default', 'Moving') on conflict (slug) do nothing;
+
+alter table moving.orders                 add column if not exists tenant_id int references moving.tenants (id);
+alter table moving.reviews                add column if not exists tenant_id int references moving.tenants (id);
+alter table moving.telegram_invites       add column if not exists tenant_id int references moving.tenants (id);
+alter table moving.telegram_subscriptions add column if not exists tenant_id int references moving.tenants (id);
+alter table moving.telegram_digest_runs   add column if not exists tenant_id int references moving.tenants (id);
+alter table moving.api_keys               add column if not exists tenant_id int references moving.tenants (id);
+-- admin users without a tenant work for every tenant.
+alter table moving.admin_users            add column if not exists tenant_id int references moving.tenants (id);
+
+update moving.orders                 set tenant_id = (select id from moving.tenants where slug = 'default');
+update moving.reviews                set tenant_id = (select id from moving.tenants where slug = 'default');
+update moving.telegram_invites       set tenant_id = (select id from moving.tenants where slug = 'default');
+update moving.telegram_subscriptions set tenant_id = (select id from moving.tenants where slug = 'default');
+update moving.telegram_digest_runs   set tenant_id = (select id from moving.tenants where slug = 'default');
+update moving.api_keys               set tenant_id = (select id from moving.tenants where slug = 'default');
+
+alter table moving.orders                 alter column tenant_id set not null;
+alter table moving.reviews                alter column tenant_id set not null;
+alter table moving.telegram_invites       alter column tenant_id set not null;
+alter table moving.telegram_subscriptions alter column tenant_id set not null;
+alter table moving.telegram_digest_runs   alter column tenant_id set not null;
+alter table moving.api_keys               alter column tenant_id set not null;
+
+alter table moving.telegram_digest_runs
+    drop constraint if exists telegram_digest_runs_pkey,
+    add primary key (tenant_id, digest, scheduled_at);
+
+create index if not exists idx_moving_orders_tenant_id_created_at on moving.orders (tenant_id, created_at);
+create index if not exists idx_moving_reviews_tenant_id_status    on moving.reviews (tenant_id, status);
+
+grant select on table moving.tenants to "moving-r";
+
+end;
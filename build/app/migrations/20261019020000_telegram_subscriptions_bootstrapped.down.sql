@@ -0,0 +1,6 @@
+begin;
+
+alter table moving.telegram_subscriptions
+    drop column if exists bootstrapped;
+
+end;
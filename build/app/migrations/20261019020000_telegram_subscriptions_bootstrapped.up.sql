@@ -0,0 +1,10 @@
+begin;
+
+-- bootstrapped chats come from the allowed chat ids of the config and are revoked once removed from it,
+-- chats joined with an invite or revoked by hand are not managed by the config.
+alter table moving.telegram_subscriptions
+    add column if not exists bootstrapped boolean not null default false;
+
+update moving.telegram_subscriptions set bootstrapped = true where invite_id is null and revoked_at is null;
+
+end;
@@ -0,0 +1,25 @@
+begin;
+
+alter table moving.sms_messages
+    drop column if exists attempts;
+
+-- enum values cannot be dropped, the type is rebuilt without them.
+alter table moving.sms_messages alter column status drop default;
+alter table moving.sms_messages alter column status type text;
+
+update moving.sms_messages set status = 'failed' where status in ('rejected', 'unconfirmed');
+
+drop type if exists moving.sms_status_enum;
+
+create type moving.sms_status_enum as enum (
+    'queued',
+    'sent',
+    'delivered',
+    'undelivered',
+    'failed'
+);
+
+alter table moving.sms_messages alter column status type moving.sms_status_enum using status::moving.sms_status_enum;
+alter table moving.sms_messages alter column status set default 'queued';
+
+end;
@@ -0,0 +1,11 @@
+begin;
+
+-- rejected messages are refused by the provider or have an invalid phone, unconfirmed ones got no answer
+-- from the provider and may have been sent, neither is sent again.
+alter type moving.sms_status_enum add value if not exists 'rejected';
+alter type moving.sms_status_enum add value if not exists 'unconfirmed';
+
+alter table moving.sms_messages
+    add column if not exists attempts int not null default 1;
+
+end;
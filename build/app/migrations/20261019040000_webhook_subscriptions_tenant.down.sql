@@ -0,0 +1,7 @@
+begin;
+
+drop index if exists moving.idx_moving_webhook_subscriptions_tenant_id;
+
+alter table moving.webhook_subscriptions drop column if exists tenant_id;
+
+end;
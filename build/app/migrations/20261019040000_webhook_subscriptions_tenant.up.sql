@@ -0,0 +1,12 @@
+begin;
+
+-- subscriptions created before tenants belong to the default tenant.
+alter table moving.webhook_subscriptions add column if not exists tenant_id int references moving.tenants (id);
+
+update moving.webhook_subscriptions set tenant_id = (select id from moving.tenants where slug = 'default');
+
+alter table moving.webhook_subscriptions alter column tenant_id set not null;
+
+create index if not exists idx_moving_webhook_subscriptions_tenant_id on moving.webhook_subscriptions (tenant_id);
+
+end;
@@ -0,0 +1,6 @@
+begin;
+
+alter table moving.idempotency_keys
+    drop column if exists locked_until;
+
+end;
@@ -0,0 +1,13 @@
+begin;
+
+-- a reservation without response is taken over once its lease expires, the lease is extended while
+-- the request runs. Reservations in progress during the migration keep the previous minute.
+alter table moving.idempotency_keys
+    add column if not exists locked_until timestamp;
+
+update moving.idempotency_keys set locked_until = created_at + interval '1 minute' where locked_until is null;
+
+alter table moving.idempotency_keys
+    alter column locked_until set not null;
+
+end;
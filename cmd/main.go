@@ -15,6 +15,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/ingvarmattis/moving/src/infra/box"
+	"github.com/ingvarmattis/moving/src/infra/config"
 )
 
 func main() {
@@ -30,11 +31,22 @@ func main() {
 		panic(err)
 	}
 
+	// watches are interrupted before the servers drain, otherwise open streams would hold the shutdown
+	streamsCTX, streamsCancel := context.WithCancel(serverCTX)
+	// workers stop after the servers, so events of the last calls are still delivered
+	workersCTX, workersCancel := context.WithCancel(serverCTX)
+	workersWG := &sync.WaitGroup{}
+
 	// working functions
 	workingFunctions := []func() error{
+		func() error {
+			reloadOnSignal(serverCTX, envBox, resources)
+
+			return nil
+		},
 		func() error {
 			if grpcServerErr := resources.GRPCServer.Serve(
-				envBox.Config.ServiceName, &envBox.Config.GRPCServerListenPort,
+				&envBox.Config.GRPCServerListenPort,
 			); grpcServerErr != nil {
 				return fmt.Errorf("cannot start grpc server | %w", grpcServerErr)
 			}
@@ -68,6 +80,46 @@ func main() {
 		},
 	}
 
+	// background workers run until their context is done, shutdown waits for them
+	backgroundWorkers := []func(){
+		func() {
+			resources.HealthChecker.Run(workersCTX)
+		},
+		func() {
+			resources.OutboxDispatcher.Run(workersCTX)
+		},
+		func() {
+			resources.OrdersService.RunWatchers(streamsCTX)
+		},
+		func() {
+			resources.TelegramBot.RunDigests(workersCTX)
+		},
+		func() {
+			if !envBox.Config.WebhooksConfig.Enabled {
+				return
+			}
+
+			resources.WebhooksService.Run(workersCTX)
+		},
+		func() {
+			if resources.SMSService == nil {
+				return
+			}
+
+			resources.SMSService.RunReminders(workersCTX)
+		},
+		func() {
+			if !envBox.Config.MetricsConfig.Enabled {
+				return
+			}
+
+			resources.BusinessMetrics.RefreshOpenOrders(
+				workersCTX, envBox.Logger, resources.OrdersStorage,
+				envBox.Config.MetricsConfig.OpenOrdersRefreshInterval,
+			)
+		},
+	}
+
 	for i := range len(workingFunctions) {
 		go func() {
 			if err = workingFunctions[i](); err != nil {
@@ -77,11 +129,21 @@ func main() {
 		}()
 	}
 
+	workersWG.Add(len(backgroundWorkers))
+
+	for _, worker := range backgroundWorkers {
+		go func() {
+			defer workersWG.Done()
+			worker()
+		}()
+	}
+
 	gracefullShutdown(
-		envBox.Logger,
-		resources.GRPCServer, envBox.PGXPool, resources.TelegramBot,
-		resources.MetricsServer,
-		envBox.TraceProvider,
+		envBox.Logger, &envBox.Config.ShutdownConfig,
+		resources.HealthChecker, streamsCancel, resources.GRPCServer,
+		workersCancel, workersWG,
+		resources.TelegramBot, resources.MetricsServer,
+		envBox.PGXPool, envBox.TraceProvider,
 	)
 
 	serverCancel()
@@ -89,6 +151,34 @@ func main() {
 	envBox.Logger.Info("service has been shutdown")
 }
 
+// reloadOnSignal reloads the config on SIGHUP until ctx is done, a broken config keeps the current one.
+func reloadOnSignal(ctx context.Context, envBox *box.Env, resources *box.Resources) {
+	reload := make(chan os.Signal, 1)
+	signal.Notify(reload, syscall.SIGHUP)
+	defer signal.Stop(reload)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-reload:
+		}
+
+		cfg, err := config.Load()
+		if err != nil {
+			envBox.Logger.Error("failed to reload config", zap.Error(err))
+			continue
+		}
+
+		if err = resources.Reload(ctx, envBox, cfg); err != nil {
+			envBox.Logger.Error("failed to apply reloaded config", zap.Error(err))
+			continue
+		}
+
+		envBox.Logger.Info("config has been reloaded")
+	}
+}
+
 type (
 	closer interface {
 		Close()
@@ -100,70 +190,99 @@ type (
 	shutdowner interface {
 		Shutdown(ctx context.Context) error
 	}
+	readinessShutdowner interface {
+		Shutdown()
+	}
+	serversShutdowner interface {
+		ShutdownHTTP(ctx context.Context) error
+		Shutdown(ctx context.Context) error
+	}
 )
 
+// gracefullShutdown stops the service in order within cfg.Timeout: readiness is turned off,
+// watch streams are interrupted, http and grpc calls in flight are drained, background workers
+// deliver what they claimed, then telegram, postgres and the tracer are closed.
 func gracefullShutdown(
-	logger *zap.Logger,
-	serverGRPC, pgxPool, telegramBot closer,
-	metricsServerHTTP metricsCloser,
-	traceProvider shutdowner,
+	logger *zap.Logger, cfg *config.ShutdownConfig,
+	readiness readinessShutdowner, stopStreams func(), servers serversShutdowner,
+	stopWorkers func(), workers *sync.WaitGroup,
+	telegramBot closer, metricsServerHTTP metricsCloser,
+	pgxPool closer, traceProvider shutdowner,
 ) {
 	quit := make(chan os.Signal, 1)
 	signal.Notify(
-		quit, syscall.SIGINT, syscall.SIGTERM, syscall.SIGHUP, syscall.SIGQUIT,
+		quit, syscall.SIGINT, syscall.SIGTERM, syscall.SIGQUIT,
 	)
 	<-quit
 
-	logger.Info("shutting down service...")
+	logger.Info("shutting down service...", zap.Duration("timeout", cfg.Timeout))
 
-	shutdownWG := &sync.WaitGroup{}
-	shutdownFunctions := []func(){
-		func() {
-			defer shutdownWG.Done()
-			serverGRPC.Close()
-		},
-		func() {
-			defer shutdownWG.Done()
-			if metricsServerHTTP.Name() == box.NotOperational {
-				return
-			}
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.Timeout)
+	defer cancel()
 
-			if err := metricsServerHTTP.Close(); err != nil {
-				logger.Error("failed to close metrics server", zap.Error(err))
-			}
-		},
-		func() {
-			defer shutdownWG.Done()
-			telegramBot.Close()
-		},
-		func() {
-			defer shutdownWG.Done()
-			pgxPool.Close()
-		},
-		func() {
-			defer shutdownWG.Done()
+	// load balancers stop sending new requests while in-flight ones are finished
+	readiness.Shutdown()
 
-			if !reflect.ValueOf(traceProvider).IsNil() {
-				ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
-				defer cancel()
+	select {
+	case <-ctx.Done():
+	case <-time.After(cfg.ReadinessDelay):
+	}
 
-				if err := traceProvider.Shutdown(ctx); err != nil {
-					logger.Error("failed to close tracer", zap.Error(err))
-				}
-			}
-		},
-		func() {
-			defer shutdownWG.Done()
-			if err := logger.Sync(); err != nil {
-				logger.Error("failed to close logger", zap.Error(err))
-			}
-		},
+	stopStreams()
+
+	// the gateway calls the grpc server, so http is drained first
+	if err := servers.ShutdownHTTP(ctx); err != nil {
+		logger.Error("failed to drain http server", zap.Error(err))
+	}
+
+	if err := servers.Shutdown(ctx); err != nil {
+		logger.Error("failed to drain grpc server", zap.Error(err))
+	}
+
+	stopWorkers()
+
+	if !waitDone(ctx, workers.Wait) {
+		logger.Error("background workers did not stop in time")
+	}
+
+	if !waitDone(ctx, telegramBot.Close) {
+		logger.Error("telegram bot did not close in time")
 	}
-	shutdownWG.Add(len(shutdownFunctions))
 
-	for _, shutdown := range shutdownFunctions {
-		go shutdown()
+	if metricsServerHTTP.Name() != box.NotOperational {
+		if err := metricsServerHTTP.Close(); err != nil {
+			logger.Error("failed to close metrics server", zap.Error(err))
+		}
 	}
 
-	shutdownWG.Wait()
+	if !waitDone(ctx, pgxPool.Close) {
+		logger.Error("postgres pool did not close in time")
+	}
+
+	if !reflect.ValueOf(traceProvider).IsNil() {
+		if err := traceProvider.Shutdown(ctx); err != nil {
+			logger.Error("failed to close tracer", zap.Error(err))
+		}
+	}
+
+	if err := logger.Sync(); err != nil {
+		logger.Error("failed to close logger", zap.Error(err))
+	}
+}
+
+// waitDone reports whether f returned before ctx is done, f is left running otherwise.
+func waitDone(ctx context.Context, f func()) bool {
+	done := make(chan struct{})
+
+	go func() {
+		f()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return true
+	case <-ctx.Done():
+		return false
+	}
 }
@@ -0,0 +1,142 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.1
+// 	protoc        v5.27.0
+// source: params/arrival_window.proto
+
+package servergrpc
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ArrivalWindow int32
+
+const (
+	ArrivalWindow_ARRIVAL_WINDOW_UNKNOWN   ArrivalWindow = 0
+	ArrivalWindow_ARRIVAL_WINDOW_MORNING   ArrivalWindow = 1
+	ArrivalWindow_ARRIVAL_WINDOW_MIDDAY    ArrivalWindow = 2
+	ArrivalWindow_ARRIVAL_WINDOW_AFTERNOON ArrivalWindow = 3
+)
+
+// Enum value maps for ArrivalWindow.
+var (
+	ArrivalWindow_name = map[int32]string{
+		0: "ARRIVAL_WINDOW_UNKNOWN",
+		1: "ARRIVAL_WINDOW_MORNING",
+		2: "ARRIVAL_WINDOW_MIDDAY",
+		3: "ARRIVAL_WINDOW_AFTERNOON",
+	}
+	ArrivalWindow_value = map[string]int32{
+		"ARRIVAL_WINDOW_UNKNOWN":   0,
+		"ARRIVAL_WINDOW_MORNING":   1,
+		"ARRIVAL_WINDOW_MIDDAY":    2,
+		"ARRIVAL_WINDOW_AFTERNOON": 3,
+	}
+)
+
+func (x ArrivalWindow) Enum() *ArrivalWindow {
+	p := new(ArrivalWindow)
+	*p = x
+	return p
+}
+
+func (x ArrivalWindow) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ArrivalWindow) Descriptor() protoreflect.EnumDescriptor {
+	return file_params_arrival_window_proto_enumTypes[0].Descriptor()
+}
+
+func (ArrivalWindow) Type() protoreflect.EnumType {
+	return &file_params_arrival_window_proto_enumTypes[0]
+}
+
+func (x ArrivalWindow) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ArrivalWindow.Descriptor instead.
+func (ArrivalWindow) EnumDescriptor() ([]byte, []int) {
+	return file_params_arrival_window_proto_rawDescGZIP(), []int{0}
+}
+
+var File_params_arrival_window_proto protoreflect.FileDescriptor
+
+var file_params_arrival_window_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2f, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c,
+	0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1f, 0x69,
+	0x6e, 0x67, 0x76, 0x61, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x69, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2a, 0x80,
+	0x01, 0x0a, 0x0d, 0x41, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x12, 0x1a, 0x0a, 0x16, 0x41, 0x52, 0x52, 0x49, 0x56, 0x41, 0x4c, 0x5f, 0x57, 0x49, 0x4e, 0x44,
+	0x4f, 0x57, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16,
+	0x41, 0x52, 0x52, 0x49, 0x56, 0x41, 0x4c, 0x5f, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x5f, 0x4d,
+	0x4f, 0x52, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x41, 0x52, 0x52, 0x49,
+	0x56, 0x41, 0x4c, 0x5f, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x5f, 0x4d, 0x49, 0x44, 0x44, 0x41,
+	0x59, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x52, 0x52, 0x49, 0x56, 0x41, 0x4c, 0x5f, 0x57,
+	0x49, 0x4e, 0x44, 0x4f, 0x57, 0x5f, 0x41, 0x46, 0x54, 0x45, 0x52, 0x4e, 0x4f, 0x4f, 0x4e, 0x10,
+	0x03, 0x42, 0x24, 0x5a, 0x22, 0x2e, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x3b, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x67, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_params_arrival_window_proto_rawDescOnce sync.Once
+	file_params_arrival_window_proto_rawDescData = file_params_arrival_window_proto_rawDesc
+)
+
+func file_params_arrival_window_proto_rawDescGZIP() []byte {
+	file_params_arrival_window_proto_rawDescOnce.Do(func() {
+		file_params_arrival_window_proto_rawDescData = protoimpl.X.CompressGZIP(file_params_arrival_window_proto_rawDescData)
+	})
+	return file_params_arrival_window_proto_rawDescData
+}
+
+var file_params_arrival_window_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_params_arrival_window_proto_goTypes = []any{
+	(ArrivalWindow)(0), // 0: ingvarmattis.services.moving.v1.ArrivalWindow
+}
+var file_params_arrival_window_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_params_arrival_window_proto_init() }
+func file_params_arrival_window_proto_init() {
+	if File_params_arrival_window_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_params_arrival_window_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_params_arrival_window_proto_goTypes,
+		DependencyIndexes: file_params_arrival_window_proto_depIdxs,
+		EnumInfos:         file_params_arrival_window_proto_enumTypes,
+	}.Build()
+	File_params_arrival_window_proto = out.File
+	file_params_arrival_window_proto_rawDesc = nil
+	file_params_arrival_window_proto_goTypes = nil
+	file_params_arrival_window_proto_depIdxs = nil
+}
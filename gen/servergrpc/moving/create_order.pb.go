@@ -31,6 +31,15 @@ type CreateOrderRequest struct {
 	MoveFrom       string                 `protobuf:"bytes,6,opt,name=MoveFrom,proto3" json:"MoveFrom,omitempty"`
 	MoveTo         string                 `protobuf:"bytes,7,opt,name=MoveTo,proto3" json:"MoveTo,omitempty"`
 	AdditionalInfo *string                `protobuf:"bytes,8,opt,name=AdditionalInfo,proto3,oneof" json:"AdditionalInfo,omitempty"`
+	Source         LeadSource             `protobuf:"varint,9,opt,name=Source,proto3,enum=ingvarmattis.services.moving.v1.LeadSource" json:"Source,omitempty"`
+	UTMSource      *string                `protobuf:"bytes,10,opt,name=UTMSource,proto3,oneof" json:"UTMSource,omitempty"`
+	UTMMedium      *string                `protobuf:"bytes,11,opt,name=UTMMedium,proto3,oneof" json:"UTMMedium,omitempty"`
+	UTMCampaign    *string                `protobuf:"bytes,12,opt,name=UTMCampaign,proto3,oneof" json:"UTMCampaign,omitempty"`
+	Referrer       *string                `protobuf:"bytes,13,opt,name=Referrer,proto3,oneof" json:"Referrer,omitempty"`
+	LandingPage    *string                `protobuf:"bytes,14,opt,name=LandingPage,proto3,oneof" json:"LandingPage,omitempty"`
+	CaptchaToken   *string                `protobuf:"bytes,15,opt,name=CaptchaToken,proto3,oneof" json:"CaptchaToken,omitempty"`
+	Honeypot       *string                `protobuf:"bytes,16,opt,name=Honeypot,proto3,oneof" json:"Honeypot,omitempty"`
+	FormStartedAt  *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=FormStartedAt,proto3" json:"FormStartedAt,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -121,6 +130,69 @@ func (x *CreateOrderRequest) GetAdditionalInfo() string {
 	return ""
 }
 
+func (x *CreateOrderRequest) GetSource() LeadSource {
+	if x != nil {
+		return x.Source
+	}
+	return LeadSource_LEAD_SOURCE_UNKNOWN
+}
+
+func (x *CreateOrderRequest) GetUTMSource() string {
+	if x != nil && x.UTMSource != nil {
+		return *x.UTMSource
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetUTMMedium() string {
+	if x != nil && x.UTMMedium != nil {
+		return *x.UTMMedium
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetUTMCampaign() string {
+	if x != nil && x.UTMCampaign != nil {
+		return *x.UTMCampaign
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetReferrer() string {
+	if x != nil && x.Referrer != nil {
+		return *x.Referrer
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetLandingPage() string {
+	if x != nil && x.LandingPage != nil {
+		return *x.LandingPage
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetCaptchaToken() string {
+	if x != nil && x.CaptchaToken != nil {
+		return *x.CaptchaToken
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetHoneypot() string {
+	if x != nil && x.Honeypot != nil {
+		return *x.Honeypot
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetFormStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FormStartedAt
+	}
+	return nil
+}
+
 type CreateOrderResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Order         *Order                 `protobuf:"bytes,1,opt,name=Order,proto3" json:"Order,omitempty"`
@@ -176,38 +248,70 @@ var file_params_create_order_proto_rawDesc = []byte{
 	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x70,
 	0x61, 0x72, 0x61, 0x6d, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x5f, 0x73,
 	0x69, 0x7a, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x70, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe2, 0x02,
-	0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x51, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79,
-	0x53, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x69, 0x6e, 0x67,
-	0x76, 0x61, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x69, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f,
-	0x70, 0x65, 0x72, 0x74, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x52, 0x0c, 0x50, 0x72, 0x6f, 0x70, 0x65,
-	0x72, 0x74, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x4d, 0x6f, 0x76, 0x65, 0x44,
-	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x4d, 0x6f, 0x76, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x14,
-	0x0a, 0x05, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x50,
-	0x68, 0x6f, 0x6e, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x4d, 0x6f, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4d, 0x6f, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d,
-	0x12, 0x16, 0x0a, 0x06, 0x4d, 0x6f, 0x76, 0x65, 0x54, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x4d, 0x6f, 0x76, 0x65, 0x54, 0x6f, 0x12, 0x2b, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x01, 0x52, 0x0e, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x49, 0x6e,
-	0x66, 0x6f, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x42,
+	0x73, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x2f, 0x6c, 0x65, 0x61, 0x64, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xcf, 0x06, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x51,
+	0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x69, 0x6e, 0x67, 0x76, 0x61, 0x72, 0x6d, 0x61, 0x74,
+	0x74, 0x69, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x6f, 0x76,
+	0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x53,
+	0x69, 0x7a, 0x65, 0x52, 0x0c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x36, 0x0a, 0x08, 0x4d, 0x6f, 0x76, 0x65, 0x44, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x08, 0x4d, 0x6f, 0x76, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a,
+	0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05,
+	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x68, 0x6f, 0x6e,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x4d, 0x6f, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x4d, 0x6f, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x4d, 0x6f,
+	0x76, 0x65, 0x54, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x4d, 0x6f, 0x76, 0x65,
+	0x54, 0x6f, 0x12, 0x2b, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
+	0x49, 0x6e, 0x66, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0e, 0x41, 0x64,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x88, 0x01, 0x01, 0x12,
+	0x43, 0x0a, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x2b, 0x2e, 0x69, 0x6e, 0x67, 0x76, 0x61, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x69, 0x73, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x09, 0x55, 0x54, 0x4d, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x09, 0x55, 0x54, 0x4d, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x55, 0x54, 0x4d, 0x4d, 0x65,
+	0x64, 0x69, 0x75, 0x6d, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x09, 0x55, 0x54,
+	0x4d, 0x4d, 0x65, 0x64, 0x69, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x55, 0x54,
+	0x4d, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x04, 0x52, 0x0b, 0x55, 0x54, 0x4d, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x88, 0x01,
+	0x01, 0x12, 0x1f, 0x0a, 0x08, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x08, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x88,
+	0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x4c, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x67,
+	0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x48, 0x06, 0x52, 0x0b, 0x4c, 0x61, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x50, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0c, 0x43, 0x61, 0x70,
+	0x74, 0x63, 0x68, 0x61, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x07, 0x52, 0x0c, 0x43, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x88,
+	0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x48, 0x6f, 0x6e, 0x65, 0x79, 0x70, 0x6f, 0x74, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x08, 0x52, 0x08, 0x48, 0x6f, 0x6e, 0x65, 0x79, 0x70, 0x6f, 0x74,
+	0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x0d, 0x46, 0x6f, 0x72, 0x6d, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x46, 0x6f, 0x72, 0x6d, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x42,
 	0x11, 0x0a, 0x0f, 0x5f, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x49, 0x6e,
-	0x66, 0x6f, 0x22, 0x53, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x05, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x6e, 0x67, 0x76, 0x61,
-	0x72, 0x6d, 0x61, 0x74, 0x74, 0x69, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x52, 0x05, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x24, 0x5a, 0x22, 0x2e, 0x2f, 0x67, 0x65, 0x6e,
-	0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x6d, 0x6f, 0x76, 0x69,
-	0x6e, 0x67, 0x3b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x67, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x66, 0x6f, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x55, 0x54, 0x4d, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x55, 0x54, 0x4d, 0x4d, 0x65, 0x64, 0x69, 0x75, 0x6d, 0x42, 0x0e,
+	0x0a, 0x0c, 0x5f, 0x55, 0x54, 0x4d, 0x43, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x42, 0x0b,
+	0x0a, 0x09, 0x5f, 0x52, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x72, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
+	0x4c, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x67, 0x65, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
+	0x43, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x0b, 0x0a, 0x09,
+	0x5f, 0x48, 0x6f, 0x6e, 0x65, 0x79, 0x70, 0x6f, 0x74, 0x22, 0x53, 0x0a, 0x13, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3c, 0x0a, 0x05, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x69, 0x6e, 0x67, 0x76, 0x61, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x69, 0x73, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x2e, 0x76,
+	0x31, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x05, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x24,
+	0x5a, 0x22, 0x2e, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x67, 0x72,
+	0x70, 0x63, 0x2f, 0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x3b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x67, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -228,17 +332,20 @@ var file_params_create_order_proto_goTypes = []any{
 	(*CreateOrderResponse)(nil),   // 1: ingvarmattis.services.moving.v1.CreateOrderResponse
 	(PropertySize)(0),             // 2: ingvarmattis.services.moving.v1.PropertySize
 	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
-	(*Order)(nil),                 // 4: ingvarmattis.services.moving.v1.Order
+	(LeadSource)(0),               // 4: ingvarmattis.services.moving.v1.LeadSource
+	(*Order)(nil),                 // 5: ingvarmattis.services.moving.v1.Order
 }
 var file_params_create_order_proto_depIdxs = []int32{
 	2, // 0: ingvarmattis.services.moving.v1.CreateOrderRequest.PropertySize:type_name -> ingvarmattis.services.moving.v1.PropertySize
 	3, // 1: ingvarmattis.services.moving.v1.CreateOrderRequest.MoveDate:type_name -> google.protobuf.Timestamp
-	4, // 2: ingvarmattis.services.moving.v1.CreateOrderResponse.Order:type_name -> ingvarmattis.services.moving.v1.Order
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	4, // 2: ingvarmattis.services.moving.v1.CreateOrderRequest.Source:type_name -> ingvarmattis.services.moving.v1.LeadSource
+	3, // 3: ingvarmattis.services.moving.v1.CreateOrderRequest.FormStartedAt:type_name -> google.protobuf.Timestamp
+	5, // 4: ingvarmattis.services.moving.v1.CreateOrderResponse.Order:type_name -> ingvarmattis.services.moving.v1.Order
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_params_create_order_proto_init() }
@@ -248,6 +355,7 @@ func file_params_create_order_proto_init() {
 	}
 	file_params_property_size_proto_init()
 	file_params_order_proto_init()
+	file_params_lead_source_proto_init()
 	file_params_create_order_proto_msgTypes[0].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
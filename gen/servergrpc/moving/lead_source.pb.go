@@ -0,0 +1,160 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.1
+// 	protoc        v5.27.0
+// source: params/lead_source.proto
+
+package servergrpc
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type LeadSource int32
+
+const (
+	LeadSource_LEAD_SOURCE_UNKNOWN      LeadSource = 0
+	LeadSource_LEAD_SOURCE_WEBSITE      LeadSource = 1
+	LeadSource_LEAD_SOURCE_YELP         LeadSource = 2
+	LeadSource_LEAD_SOURCE_GOOGLE_ADS   LeadSource = 3
+	LeadSource_LEAD_SOURCE_FACEBOOK_ADS LeadSource = 4
+	LeadSource_LEAD_SOURCE_REFERRAL     LeadSource = 5
+	LeadSource_LEAD_SOURCE_PHONE        LeadSource = 6
+	LeadSource_LEAD_SOURCE_OTHER        LeadSource = 7
+)
+
+// Enum value maps for LeadSource.
+var (
+	LeadSource_name = map[int32]string{
+		0: "LEAD_SOURCE_UNKNOWN",
+		1: "LEAD_SOURCE_WEBSITE",
+		2: "LEAD_SOURCE_YELP",
+		3: "LEAD_SOURCE_GOOGLE_ADS",
+		4: "LEAD_SOURCE_FACEBOOK_ADS",
+		5: "LEAD_SOURCE_REFERRAL",
+		6: "LEAD_SOURCE_PHONE",
+		7: "LEAD_SOURCE_OTHER",
+	}
+	LeadSource_value = map[string]int32{
+		"LEAD_SOURCE_UNKNOWN":      0,
+		"LEAD_SOURCE_WEBSITE":      1,
+		"LEAD_SOURCE_YELP":         2,
+		"LEAD_SOURCE_GOOGLE_ADS":   3,
+		"LEAD_SOURCE_FACEBOOK_ADS": 4,
+		"LEAD_SOURCE_REFERRAL":     5,
+		"LEAD_SOURCE_PHONE":        6,
+		"LEAD_SOURCE_OTHER":        7,
+	}
+)
+
+func (x LeadSource) Enum() *LeadSource {
+	p := new(LeadSource)
+	*p = x
+	return p
+}
+
+func (x LeadSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LeadSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_params_lead_source_proto_enumTypes[0].Descriptor()
+}
+
+func (LeadSource) Type() protoreflect.EnumType {
+	return &file_params_lead_source_proto_enumTypes[0]
+}
+
+func (x LeadSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LeadSource.Descriptor instead.
+func (LeadSource) EnumDescriptor() ([]byte, []int) {
+	return file_params_lead_source_proto_rawDescGZIP(), []int{0}
+}
+
+var File_params_lead_source_proto protoreflect.FileDescriptor
+
+var file_params_lead_source_proto_rawDesc = []byte{
+	0x0a, 0x18, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2f, 0x6c, 0x65, 0x61, 0x64, 0x5f, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1f, 0x69, 0x6e, 0x67, 0x76,
+	0x61, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x69, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2a, 0xd6, 0x01, 0x0a, 0x0a,
+	0x4c, 0x65, 0x61, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x17, 0x0a, 0x13, 0x4c, 0x45,
+	0x41, 0x44, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
+	0x4e, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x4c, 0x45, 0x41, 0x44, 0x5f, 0x53, 0x4f, 0x55, 0x52,
+	0x43, 0x45, 0x5f, 0x57, 0x45, 0x42, 0x53, 0x49, 0x54, 0x45, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10,
+	0x4c, 0x45, 0x41, 0x44, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x59, 0x45, 0x4c, 0x50,
+	0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x4c, 0x45, 0x41, 0x44, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43,
+	0x45, 0x5f, 0x47, 0x4f, 0x4f, 0x47, 0x4c, 0x45, 0x5f, 0x41, 0x44, 0x53, 0x10, 0x03, 0x12, 0x1c,
+	0x0a, 0x18, 0x4c, 0x45, 0x41, 0x44, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x46, 0x41,
+	0x43, 0x45, 0x42, 0x4f, 0x4f, 0x4b, 0x5f, 0x41, 0x44, 0x53, 0x10, 0x04, 0x12, 0x18, 0x0a, 0x14,
+	0x4c, 0x45, 0x41, 0x44, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x52, 0x45, 0x46, 0x45,
+	0x52, 0x52, 0x41, 0x4c, 0x10, 0x05, 0x12, 0x15, 0x0a, 0x11, 0x4c, 0x45, 0x41, 0x44, 0x5f, 0x53,
+	0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x50, 0x48, 0x4f, 0x4e, 0x45, 0x10, 0x06, 0x12, 0x15, 0x0a,
+	0x11, 0x4c, 0x45, 0x41, 0x44, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x4f, 0x54, 0x48,
+	0x45, 0x52, 0x10, 0x07, 0x42, 0x24, 0x5a, 0x22, 0x2e, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x3b,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x67, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_params_lead_source_proto_rawDescOnce sync.Once
+	file_params_lead_source_proto_rawDescData = file_params_lead_source_proto_rawDesc
+)
+
+func file_params_lead_source_proto_rawDescGZIP() []byte {
+	file_params_lead_source_proto_rawDescOnce.Do(func() {
+		file_params_lead_source_proto_rawDescData = protoimpl.X.CompressGZIP(file_params_lead_source_proto_rawDescData)
+	})
+	return file_params_lead_source_proto_rawDescData
+}
+
+var file_params_lead_source_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_params_lead_source_proto_goTypes = []any{
+	(LeadSource)(0), // 0: ingvarmattis.services.moving.v1.LeadSource
+}
+var file_params_lead_source_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_params_lead_source_proto_init() }
+func file_params_lead_source_proto_init() {
+	if File_params_lead_source_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_params_lead_source_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_params_lead_source_proto_goTypes,
+		DependencyIndexes: file_params_lead_source_proto_depIdxs,
+		EnumInfos:         file_params_lead_source_proto_enumTypes,
+	}.Build()
+	File_params_lead_source_proto = out.File
+	file_params_lead_source_proto_rawDesc = nil
+	file_params_lead_source_proto_goTypes = nil
+	file_params_lead_source_proto_depIdxs = nil
+}
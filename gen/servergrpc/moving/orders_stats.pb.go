@@ -0,0 +1,603 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.1
+// 	protoc        v5.27.0
+// source: params/orders_stats.proto
+
+package servergrpc
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type StatsGranularity int32
+
+const (
+	StatsGranularity_STATS_GRANULARITY_UNKNOWN StatsGranularity = 0
+	StatsGranularity_STATS_GRANULARITY_DAY     StatsGranularity = 1
+	StatsGranularity_STATS_GRANULARITY_WEEK    StatsGranularity = 2
+	StatsGranularity_STATS_GRANULARITY_MONTH   StatsGranularity = 3
+)
+
+// Enum value maps for StatsGranularity.
+var (
+	StatsGranularity_name = map[int32]string{
+		0: "STATS_GRANULARITY_UNKNOWN",
+		1: "STATS_GRANULARITY_DAY",
+		2: "STATS_GRANULARITY_WEEK",
+		3: "STATS_GRANULARITY_MONTH",
+	}
+	StatsGranularity_value = map[string]int32{
+		"STATS_GRANULARITY_UNKNOWN": 0,
+		"STATS_GRANULARITY_DAY":     1,
+		"STATS_GRANULARITY_WEEK":    2,
+		"STATS_GRANULARITY_MONTH":   3,
+	}
+)
+
+func (x StatsGranularity) Enum() *StatsGranularity {
+	p := new(StatsGranularity)
+	*p = x
+	return p
+}
+
+func (x StatsGranularity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatsGranularity) Descriptor() protoreflect.EnumDescriptor {
+	return file_params_orders_stats_proto_enumTypes[0].Descriptor()
+}
+
+func (StatsGranularity) Type() protoreflect.EnumType {
+	return &file_params_orders_stats_proto_enumTypes[0]
+}
+
+func (x StatsGranularity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatsGranularity.Descriptor instead.
+func (StatsGranularity) EnumDescriptor() ([]byte, []int) {
+	return file_params_orders_stats_proto_rawDescGZIP(), []int{0}
+}
+
+type OrdersStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *Filter                `protobuf:"bytes,1,opt,name=Filter,proto3" json:"Filter,omitempty"`
+	Granularity   StatsGranularity       `protobuf:"varint,2,opt,name=Granularity,proto3,enum=ingvarmattis.services.moving.v1.StatsGranularity" json:"Granularity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrdersStatsRequest) Reset() {
+	*x = OrdersStatsRequest{}
+	mi := &file_params_orders_stats_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrdersStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrdersStatsRequest) ProtoMessage() {}
+
+func (x *OrdersStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_params_orders_stats_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrdersStatsRequest.ProtoReflect.Descriptor instead.
+func (*OrdersStatsRequest) Descriptor() ([]byte, []int) {
+	return file_params_orders_stats_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *OrdersStatsRequest) GetFilter() *Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *OrdersStatsRequest) GetGranularity() StatsGranularity {
+	if x != nil {
+		return x.Granularity
+	}
+	return StatsGranularity_STATS_GRANULARITY_UNKNOWN
+}
+
+type OrderStatusCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderStatus   OrderStatus            `protobuf:"varint,1,opt,name=OrderStatus,proto3,enum=ingvarmattis.services.moving.v1.OrderStatus" json:"OrderStatus,omitempty"`
+	Count         uint64                 `protobuf:"varint,2,opt,name=Count,proto3" json:"Count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderStatusCount) Reset() {
+	*x = OrderStatusCount{}
+	mi := &file_params_orders_stats_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderStatusCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderStatusCount) ProtoMessage() {}
+
+func (x *OrderStatusCount) ProtoReflect() protoreflect.Message {
+	mi := &file_params_orders_stats_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderStatusCount.ProtoReflect.Descriptor instead.
+func (*OrderStatusCount) Descriptor() ([]byte, []int) {
+	return file_params_orders_stats_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OrderStatusCount) GetOrderStatus() OrderStatus {
+	if x != nil {
+		return x.OrderStatus
+	}
+	return OrderStatus_ORDER_STATUS_UNKNOWN
+}
+
+func (x *OrderStatusCount) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type PropertySizeCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PropertySize  PropertySize           `protobuf:"varint,1,opt,name=PropertySize,proto3,enum=ingvarmattis.services.moving.v1.PropertySize" json:"PropertySize,omitempty"`
+	Count         uint64                 `protobuf:"varint,2,opt,name=Count,proto3" json:"Count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PropertySizeCount) Reset() {
+	*x = PropertySizeCount{}
+	mi := &file_params_orders_stats_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PropertySizeCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PropertySizeCount) ProtoMessage() {}
+
+func (x *PropertySizeCount) ProtoReflect() protoreflect.Message {
+	mi := &file_params_orders_stats_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PropertySizeCount.ProtoReflect.Descriptor instead.
+func (*PropertySizeCount) Descriptor() ([]byte, []int) {
+	return file_params_orders_stats_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PropertySizeCount) GetPropertySize() PropertySize {
+	if x != nil {
+		return x.PropertySize
+	}
+	return PropertySize_PROPERTY_SIZE_UNKNOWN
+}
+
+func (x *PropertySizeCount) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type LeadSourceCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Source        LeadSource             `protobuf:"varint,1,opt,name=Source,proto3,enum=ingvarmattis.services.moving.v1.LeadSource" json:"Source,omitempty"`
+	Count         uint64                 `protobuf:"varint,2,opt,name=Count,proto3" json:"Count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeadSourceCount) Reset() {
+	*x = LeadSourceCount{}
+	mi := &file_params_orders_stats_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeadSourceCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeadSourceCount) ProtoMessage() {}
+
+func (x *LeadSourceCount) ProtoReflect() protoreflect.Message {
+	mi := &file_params_orders_stats_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeadSourceCount.ProtoReflect.Descriptor instead.
+func (*LeadSourceCount) Descriptor() ([]byte, []int) {
+	return file_params_orders_stats_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *LeadSourceCount) GetSource() LeadSource {
+	if x != nil {
+		return x.Source
+	}
+	return LeadSource_LEAD_SOURCE_UNKNOWN
+}
+
+func (x *LeadSourceCount) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type PeriodCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PeriodStart   *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=PeriodStart,proto3" json:"PeriodStart,omitempty"`
+	Count         uint64                 `protobuf:"varint,2,opt,name=Count,proto3" json:"Count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PeriodCount) Reset() {
+	*x = PeriodCount{}
+	mi := &file_params_orders_stats_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeriodCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeriodCount) ProtoMessage() {}
+
+func (x *PeriodCount) ProtoReflect() protoreflect.Message {
+	mi := &file_params_orders_stats_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeriodCount.ProtoReflect.Descriptor instead.
+func (*PeriodCount) Descriptor() ([]byte, []int) {
+	return file_params_orders_stats_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PeriodCount) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *PeriodCount) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type OrdersStatsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Total          uint64                 `protobuf:"varint,1,opt,name=Total,proto3" json:"Total,omitempty"`
+	ByOrderStatus  []*OrderStatusCount    `protobuf:"bytes,2,rep,name=ByOrderStatus,proto3" json:"ByOrderStatus,omitempty"`
+	ByPropertySize []*PropertySizeCount   `protobuf:"bytes,3,rep,name=ByPropertySize,proto3" json:"ByPropertySize,omitempty"`
+	ByCreatedAt    []*PeriodCount         `protobuf:"bytes,4,rep,name=ByCreatedAt,proto3" json:"ByCreatedAt,omitempty"`
+	ByMoveDate     []*PeriodCount         `protobuf:"bytes,5,rep,name=ByMoveDate,proto3" json:"ByMoveDate,omitempty"`
+	ConversionRate float64                `protobuf:"fixed64,6,opt,name=ConversionRate,proto3" json:"ConversionRate,omitempty"`
+	BySource       []*LeadSourceCount     `protobuf:"bytes,7,rep,name=BySource,proto3" json:"BySource,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *OrdersStatsResponse) Reset() {
+	*x = OrdersStatsResponse{}
+	mi := &file_params_orders_stats_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrdersStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrdersStatsResponse) ProtoMessage() {}
+
+func (x *OrdersStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_params_orders_stats_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrdersStatsResponse.ProtoReflect.Descriptor instead.
+func (*OrdersStatsResponse) Descriptor() ([]byte, []int) {
+	return file_params_orders_stats_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *OrdersStatsResponse) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *OrdersStatsResponse) GetByOrderStatus() []*OrderStatusCount {
+	if x != nil {
+		return x.ByOrderStatus
+	}
+	return nil
+}
+
+func (x *OrdersStatsResponse) GetByPropertySize() []*PropertySizeCount {
+	if x != nil {
+		return x.ByPropertySize
+	}
+	return nil
+}
+
+func (x *OrdersStatsResponse) GetByCreatedAt() []*PeriodCount {
+	if x != nil {
+		return x.ByCreatedAt
+	}
+	return nil
+}
+
+func (x *OrdersStatsResponse) GetByMoveDate() []*PeriodCount {
+	if x != nil {
+		return x.ByMoveDate
+	}
+	return nil
+}
+
+func (x *OrdersStatsResponse) GetConversionRate() float64 {
+	if x != nil {
+		return x.ConversionRate
+	}
+	return 0
+}
+
+func (x *OrdersStatsResponse) GetBySource() []*LeadSourceCount {
+	if x != nil {
+		return x.BySource
+	}
+	return nil
+}
+
+var File_params_orders_stats_proto protoreflect.FileDescriptor
+
+var file_params_orders_stats_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1f, 0x69, 0x6e, 0x67,
+	0x76, 0x61, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x69, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2f, 0x70, 0x72, 0x6f,
+	0x70, 0x65, 0x72, 0x74, 0x79, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x18, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2f, 0x6c, 0x65, 0x61, 0x64, 0x5f, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xaa, 0x01, 0x0a, 0x12, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x73, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x3f, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x69, 0x6e, 0x67, 0x76, 0x61, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x69, 0x73,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67,
+	0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x12, 0x53, 0x0a, 0x0b, 0x47, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x31, 0x2e, 0x69, 0x6e, 0x67, 0x76, 0x61, 0x72,
+	0x6d, 0x61, 0x74, 0x74, 0x69, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x47,
+	0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x52, 0x0b, 0x47, 0x72, 0x61, 0x6e,
+	0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x22, 0x78, 0x0a, 0x10, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x4e, 0x0a, 0x0b, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x2c, 0x2e, 0x69, 0x6e, 0x67, 0x76, 0x61, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x69, 0x73, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x2e,
+	0x76, 0x31, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0b,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0x7c, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x53, 0x69, 0x7a,
+	0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x51, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72,
+	0x74, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d, 0x2e, 0x69,
+	0x6e, 0x67, 0x76, 0x61, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x69, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x52, 0x0c, 0x50, 0x72, 0x6f,
+	0x70, 0x65, 0x72, 0x74, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x6c, 0x0a, 0x0f, 0x4c, 0x65, 0x61, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x43, 0x0a, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x69, 0x6e, 0x67, 0x76, 0x61, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x69,
+	0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x6e,
+	0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52,
+	0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x61, 0x0a,
+	0x0b, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3c, 0x0a, 0x0b,
+	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x50,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0xf4, 0x03, 0x0a, 0x13, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x57,
+	0x0a, 0x0d, 0x42, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x69, 0x6e, 0x67, 0x76, 0x61, 0x72, 0x6d, 0x61,
+	0x74, 0x74, 0x69, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x6f,
+	0x76, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0d, 0x42, 0x79, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x5a, 0x0a, 0x0e, 0x42, 0x79, 0x50, 0x72, 0x6f,
+	0x70, 0x65, 0x72, 0x74, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x32, 0x2e, 0x69, 0x6e, 0x67, 0x76, 0x61, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x69, 0x73, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x0e, 0x42, 0x79, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x4e, 0x0a, 0x0b, 0x42, 0x79, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x6e, 0x67, 0x76, 0x61,
+	0x72, 0x6d, 0x61, 0x74, 0x74, 0x69, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0b, 0x42, 0x79, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x4c, 0x0a, 0x0a, 0x42, 0x79, 0x4d, 0x6f, 0x76, 0x65, 0x44, 0x61, 0x74,
+	0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x6e, 0x67, 0x76, 0x61, 0x72,
+	0x6d, 0x61, 0x74, 0x74, 0x69, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0a, 0x42, 0x79, 0x4d, 0x6f, 0x76, 0x65, 0x44, 0x61, 0x74,
+	0x65, 0x12, 0x26, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x43, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x4c, 0x0a, 0x08, 0x42, 0x79, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x69, 0x6e,
+	0x67, 0x76, 0x61, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x69, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65,
+	0x61, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x08, 0x42,
+	0x79, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2a, 0x85, 0x01, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x47, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x0a, 0x19,
+	0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x47, 0x52, 0x41, 0x4e, 0x55, 0x4c, 0x41, 0x52, 0x49, 0x54,
+	0x59, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x53,
+	0x54, 0x41, 0x54, 0x53, 0x5f, 0x47, 0x52, 0x41, 0x4e, 0x55, 0x4c, 0x41, 0x52, 0x49, 0x54, 0x59,
+	0x5f, 0x44, 0x41, 0x59, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f,
+	0x47, 0x52, 0x41, 0x4e, 0x55, 0x4c, 0x41, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x57, 0x45, 0x45, 0x4b,
+	0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x47, 0x52, 0x41, 0x4e,
+	0x55, 0x4c, 0x41, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x10, 0x03, 0x42,
+	0x24, 0x5a, 0x22, 0x2e, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x67,
+	0x72, 0x70, 0x63, 0x2f, 0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x3b, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x67, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_params_orders_stats_proto_rawDescOnce sync.Once
+	file_params_orders_stats_proto_rawDescData = file_params_orders_stats_proto_rawDesc
+)
+
+func file_params_orders_stats_proto_rawDescGZIP() []byte {
+	file_params_orders_stats_proto_rawDescOnce.Do(func() {
+		file_params_orders_stats_proto_rawDescData = protoimpl.X.CompressGZIP(file_params_orders_stats_proto_rawDescData)
+	})
+	return file_params_orders_stats_proto_rawDescData
+}
+
+var file_params_orders_stats_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_params_orders_stats_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_params_orders_stats_proto_goTypes = []any{
+	(StatsGranularity)(0),         // 0: ingvarmattis.services.moving.v1.StatsGranularity
+	(*OrdersStatsRequest)(nil),    // 1: ingvarmattis.services.moving.v1.OrdersStatsRequest
+	(*OrderStatusCount)(nil),      // 2: ingvarmattis.services.moving.v1.OrderStatusCount
+	(*PropertySizeCount)(nil),     // 3: ingvarmattis.services.moving.v1.PropertySizeCount
+	(*LeadSourceCount)(nil),       // 4: ingvarmattis.services.moving.v1.LeadSourceCount
+	(*PeriodCount)(nil),           // 5: ingvarmattis.services.moving.v1.PeriodCount
+	(*OrdersStatsResponse)(nil),   // 6: ingvarmattis.services.moving.v1.OrdersStatsResponse
+	(*Filter)(nil),                // 7: ingvarmattis.services.moving.v1.Filter
+	(OrderStatus)(0),              // 8: ingvarmattis.services.moving.v1.OrderStatus
+	(PropertySize)(0),             // 9: ingvarmattis.services.moving.v1.PropertySize
+	(LeadSource)(0),               // 10: ingvarmattis.services.moving.v1.LeadSource
+	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+}
+var file_params_orders_stats_proto_depIdxs = []int32{
+	7,  // 0: ingvarmattis.services.moving.v1.OrdersStatsRequest.Filter:type_name -> ingvarmattis.services.moving.v1.Filter
+	0,  // 1: ingvarmattis.services.moving.v1.OrdersStatsRequest.Granularity:type_name -> ingvarmattis.services.moving.v1.StatsGranularity
+	8,  // 2: ingvarmattis.services.moving.v1.OrderStatusCount.OrderStatus:type_name -> ingvarmattis.services.moving.v1.OrderStatus
+	9,  // 3: ingvarmattis.services.moving.v1.PropertySizeCount.PropertySize:type_name -> ingvarmattis.services.moving.v1.PropertySize
+	10, // 4: ingvarmattis.services.moving.v1.LeadSourceCount.Source:type_name -> ingvarmattis.services.moving.v1.LeadSource
+	11, // 5: ingvarmattis.services.moving.v1.PeriodCount.PeriodStart:type_name -> google.protobuf.Timestamp
+	2,  // 6: ingvarmattis.services.moving.v1.OrdersStatsResponse.ByOrderStatus:type_name -> ingvarmattis.services.moving.v1.OrderStatusCount
+	3,  // 7: ingvarmattis.services.moving.v1.OrdersStatsResponse.ByPropertySize:type_name -> ingvarmattis.services.moving.v1.PropertySizeCount
+	5,  // 8: ingvarmattis.services.moving.v1.OrdersStatsResponse.ByCreatedAt:type_name -> ingvarmattis.services.moving.v1.PeriodCount
+	5,  // 9: ingvarmattis.services.moving.v1.OrdersStatsResponse.ByMoveDate:type_name -> ingvarmattis.services.moving.v1.PeriodCount
+	4,  // 10: ingvarmattis.services.moving.v1.OrdersStatsResponse.BySource:type_name -> ingvarmattis.services.moving.v1.LeadSourceCount
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_params_orders_stats_proto_init() }
+func file_params_orders_stats_proto_init() {
+	if File_params_orders_stats_proto != nil {
+		return
+	}
+	file_params_order_status_proto_init()
+	file_params_orders_filter_proto_init()
+	file_params_property_size_proto_init()
+	file_params_lead_source_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_params_orders_stats_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_params_orders_stats_proto_goTypes,
+		DependencyIndexes: file_params_orders_stats_proto_depIdxs,
+		EnumInfos:         file_params_orders_stats_proto_enumTypes,
+		MessageInfos:      file_params_orders_stats_proto_msgTypes,
+	}.Build()
+	File_params_orders_stats_proto = out.File
+	file_params_orders_stats_proto_rawDesc = nil
+	file_params_orders_stats_proto_goTypes = nil
+	file_params_orders_stats_proto_depIdxs = nil
+}
@@ -0,0 +1,398 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.1
+// 	protoc        v5.27.0
+// source: params/telegram.proto
+
+package servergrpc
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TelegramInvite struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Code is sent to the bot as "/start <code>" from the chat to authorize.
+	Code          string                 `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=ExpiresAt,proto3" json:"ExpiresAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TelegramInvite) Reset() {
+	*x = TelegramInvite{}
+	mi := &file_params_telegram_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TelegramInvite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TelegramInvite) ProtoMessage() {}
+
+func (x *TelegramInvite) ProtoReflect() protoreflect.Message {
+	mi := &file_params_telegram_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TelegramInvite.ProtoReflect.Descriptor instead.
+func (*TelegramInvite) Descriptor() ([]byte, []int) {
+	return file_params_telegram_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TelegramInvite) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *TelegramInvite) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type CreateTelegramInviteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invite        *TelegramInvite        `protobuf:"bytes,1,opt,name=Invite,proto3" json:"Invite,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTelegramInviteResponse) Reset() {
+	*x = CreateTelegramInviteResponse{}
+	mi := &file_params_telegram_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTelegramInviteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTelegramInviteResponse) ProtoMessage() {}
+
+func (x *CreateTelegramInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_params_telegram_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTelegramInviteResponse.ProtoReflect.Descriptor instead.
+func (*CreateTelegramInviteResponse) Descriptor() ([]byte, []int) {
+	return file_params_telegram_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateTelegramInviteResponse) GetInvite() *TelegramInvite {
+	if x != nil {
+		return x.Invite
+	}
+	return nil
+}
+
+type TelegramChat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChatID        int64                  `protobuf:"varint,1,opt,name=ChatID,proto3" json:"ChatID,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=Title,proto3" json:"Title,omitempty"`
+	NewOrders     bool                   `protobuf:"varint,3,opt,name=NewOrders,proto3" json:"NewOrders,omitempty"`
+	StatusChanges bool                   `protobuf:"varint,4,opt,name=StatusChanges,proto3" json:"StatusChanges,omitempty"`
+	Digests       bool                   `protobuf:"varint,5,opt,name=Digests,proto3" json:"Digests,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TelegramChat) Reset() {
+	*x = TelegramChat{}
+	mi := &file_params_telegram_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TelegramChat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TelegramChat) ProtoMessage() {}
+
+func (x *TelegramChat) ProtoReflect() protoreflect.Message {
+	mi := &file_params_telegram_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TelegramChat.ProtoReflect.Descriptor instead.
+func (*TelegramChat) Descriptor() ([]byte, []int) {
+	return file_params_telegram_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TelegramChat) GetChatID() int64 {
+	if x != nil {
+		return x.ChatID
+	}
+	return 0
+}
+
+func (x *TelegramChat) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TelegramChat) GetNewOrders() bool {
+	if x != nil {
+		return x.NewOrders
+	}
+	return false
+}
+
+func (x *TelegramChat) GetStatusChanges() bool {
+	if x != nil {
+		return x.StatusChanges
+	}
+	return false
+}
+
+func (x *TelegramChat) GetDigests() bool {
+	if x != nil {
+		return x.Digests
+	}
+	return false
+}
+
+func (x *TelegramChat) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type TelegramChatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chats         []*TelegramChat        `protobuf:"bytes,1,rep,name=Chats,proto3" json:"Chats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TelegramChatsResponse) Reset() {
+	*x = TelegramChatsResponse{}
+	mi := &file_params_telegram_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TelegramChatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TelegramChatsResponse) ProtoMessage() {}
+
+func (x *TelegramChatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_params_telegram_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TelegramChatsResponse.ProtoReflect.Descriptor instead.
+func (*TelegramChatsResponse) Descriptor() ([]byte, []int) {
+	return file_params_telegram_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TelegramChatsResponse) GetChats() []*TelegramChat {
+	if x != nil {
+		return x.Chats
+	}
+	return nil
+}
+
+type RevokeTelegramChatRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChatID        int64                  `protobuf:"varint,1,opt,name=ChatID,proto3" json:"ChatID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeTelegramChatRequest) Reset() {
+	*x = RevokeTelegramChatRequest{}
+	mi := &file_params_telegram_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeTelegramChatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeTelegramChatRequest) ProtoMessage() {}
+
+func (x *RevokeTelegramChatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_params_telegram_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeTelegramChatRequest.ProtoReflect.Descriptor instead.
+func (*RevokeTelegramChatRequest) Descriptor() ([]byte, []int) {
+	return file_params_telegram_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RevokeTelegramChatRequest) GetChatID() int64 {
+	if x != nil {
+		return x.ChatID
+	}
+	return 0
+}
+
+var File_params_telegram_proto protoreflect.FileDescriptor
+
+var file_params_telegram_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2f, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61,
+	0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1f, 0x69, 0x6e, 0x67, 0x76, 0x61, 0x72, 0x6d,
+	0x61, 0x74, 0x74, 0x69, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d,
+	0x6f, 0x76, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x5e, 0x0a, 0x0e, 0x54, 0x65, 0x6c,
+	0x65, 0x67, 0x72, 0x61, 0x6d, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x43,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x38, 0x0a, 0x09, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x67, 0x0a, 0x1c, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x06, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x6e, 0x67, 0x76,
+	0x61, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x69, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x6c, 0x65,
+	0x67, 0x72, 0x61, 0x6d, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x06, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x22, 0xd4, 0x01, 0x0a, 0x0c, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x43,
+	0x68, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x43, 0x68, 0x61, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x43, 0x68, 0x61, 0x74, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x54,
+	0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x69, 0x74, 0x6c,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x4e, 0x65, 0x77, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x4e, 0x65, 0x77, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12,
+	0x24, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x73, 0x12,
+	0x38, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x5c, 0x0a, 0x15, 0x54, 0x65, 0x6c,
+	0x65, 0x67, 0x72, 0x61, 0x6d, 0x43, 0x68, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x43, 0x0a, 0x05, 0x43, 0x68, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2d, 0x2e, 0x69, 0x6e, 0x67, 0x76, 0x61, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x69, 0x73,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x43, 0x68, 0x61, 0x74,
+	0x52, 0x05, 0x43, 0x68, 0x61, 0x74, 0x73, 0x22, 0x33, 0x0a, 0x19, 0x52, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x43, 0x68, 0x61, 0x74, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x43, 0x68, 0x61, 0x74, 0x49, 0x44, 0x42, 0x24, 0x5a, 0x22,
+	0x2e, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x67, 0x72, 0x70, 0x63,
+	0x2f, 0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x3b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x67, 0x72,
+	0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_params_telegram_proto_rawDescOnce sync.Once
+	file_params_telegram_proto_rawDescData = file_params_telegram_proto_rawDesc
+)
+
+func file_params_telegram_proto_rawDescGZIP() []byte {
+	file_params_telegram_proto_rawDescOnce.Do(func() {
+		file_params_telegram_proto_rawDescData = protoimpl.X.CompressGZIP(file_params_telegram_proto_rawDescData)
+	})
+	return file_params_telegram_proto_rawDescData
+}
+
+var file_params_telegram_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_params_telegram_proto_goTypes = []any{
+	(*TelegramInvite)(nil),               // 0: ingvarmattis.services.moving.v1.TelegramInvite
+	(*CreateTelegramInviteResponse)(nil), // 1: ingvarmattis.services.moving.v1.CreateTelegramInviteResponse
+	(*TelegramChat)(nil),                 // 2: ingvarmattis.services.moving.v1.TelegramChat
+	(*TelegramChatsResponse)(nil),        // 3: ingvarmattis.services.moving.v1.TelegramChatsResponse
+	(*RevokeTelegramChatRequest)(nil),    // 4: ingvarmattis.services.moving.v1.RevokeTelegramChatRequest
+	(*timestamppb.Timestamp)(nil),        // 5: google.protobuf.Timestamp
+}
+var file_params_telegram_proto_depIdxs = []int32{
+	5, // 0: ingvarmattis.services.moving.v1.TelegramInvite.ExpiresAt:type_name -> google.protobuf.Timestamp
+	0, // 1: ingvarmattis.services.moving.v1.CreateTelegramInviteResponse.Invite:type_name -> ingvarmattis.services.moving.v1.TelegramInvite
+	5, // 2: ingvarmattis.services.moving.v1.TelegramChat.CreatedAt:type_name -> google.protobuf.Timestamp
+	2, // 3: ingvarmattis.services.moving.v1.TelegramChatsResponse.Chats:type_name -> ingvarmattis.services.moving.v1.TelegramChat
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_params_telegram_proto_init() }
+func file_params_telegram_proto_init() {
+	if File_params_telegram_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_params_telegram_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_params_telegram_proto_goTypes,
+		DependencyIndexes: file_params_telegram_proto_depIdxs,
+		MessageInfos:      file_params_telegram_proto_msgTypes,
+	}.Build()
+	File_params_telegram_proto = out.File
+	file_params_telegram_proto_rawDesc = nil
+	file_params_telegram_proto_goTypes = nil
+	file_params_telegram_proto_depIdxs = nil
+}
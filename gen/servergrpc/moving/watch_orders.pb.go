@@ -0,0 +1,281 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.1
+// 	protoc        v5.27.0
+// source: params/watch_orders.proto
+
+package servergrpc
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type OrderChangeType int32
+
+const (
+	OrderChangeType_ORDER_CHANGE_TYPE_UNKNOWN OrderChangeType = 0
+	// An order matching the filter when the watch started.
+	OrderChangeType_ORDER_CHANGE_TYPE_SNAPSHOT OrderChangeType = 1
+	// Sent once after the snapshot, it has no order.
+	OrderChangeType_ORDER_CHANGE_TYPE_SYNCED  OrderChangeType = 2
+	OrderChangeType_ORDER_CHANGE_TYPE_CREATED OrderChangeType = 3
+	OrderChangeType_ORDER_CHANGE_TYPE_UPDATED OrderChangeType = 4
+	// An updated order which does not match the filter anymore.
+	OrderChangeType_ORDER_CHANGE_TYPE_REMOVED OrderChangeType = 5
+)
+
+// Enum value maps for OrderChangeType.
+var (
+	OrderChangeType_name = map[int32]string{
+		0: "ORDER_CHANGE_TYPE_UNKNOWN",
+		1: "ORDER_CHANGE_TYPE_SNAPSHOT",
+		2: "ORDER_CHANGE_TYPE_SYNCED",
+		3: "ORDER_CHANGE_TYPE_CREATED",
+		4: "ORDER_CHANGE_TYPE_UPDATED",
+		5: "ORDER_CHANGE_TYPE_REMOVED",
+	}
+	OrderChangeType_value = map[string]int32{
+		"ORDER_CHANGE_TYPE_UNKNOWN":  0,
+		"ORDER_CHANGE_TYPE_SNAPSHOT": 1,
+		"ORDER_CHANGE_TYPE_SYNCED":   2,
+		"ORDER_CHANGE_TYPE_CREATED":  3,
+		"ORDER_CHANGE_TYPE_UPDATED":  4,
+		"ORDER_CHANGE_TYPE_REMOVED":  5,
+	}
+)
+
+func (x OrderChangeType) Enum() *OrderChangeType {
+	p := new(OrderChangeType)
+	*p = x
+	return p
+}
+
+func (x OrderChangeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrderChangeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_params_watch_orders_proto_enumTypes[0].Descriptor()
+}
+
+func (OrderChangeType) Type() protoreflect.EnumType {
+	return &file_params_watch_orders_proto_enumTypes[0]
+}
+
+func (x OrderChangeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrderChangeType.Descriptor instead.
+func (OrderChangeType) EnumDescriptor() ([]byte, []int) {
+	return file_params_watch_orders_proto_rawDescGZIP(), []int{0}
+}
+
+type WatchOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *Filter                `protobuf:"bytes,1,opt,name=Filter,proto3" json:"Filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchOrdersRequest) Reset() {
+	*x = WatchOrdersRequest{}
+	mi := &file_params_watch_orders_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchOrdersRequest) ProtoMessage() {}
+
+func (x *WatchOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_params_watch_orders_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchOrdersRequest.ProtoReflect.Descriptor instead.
+func (*WatchOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_params_watch_orders_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WatchOrdersRequest) GetFilter() *Filter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type OrderChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          OrderChangeType        `protobuf:"varint,1,opt,name=Type,proto3,enum=ingvarmattis.services.moving.v1.OrderChangeType" json:"Type,omitempty"`
+	Order         *Order                 `protobuf:"bytes,2,opt,name=Order,proto3" json:"Order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderChange) Reset() {
+	*x = OrderChange{}
+	mi := &file_params_watch_orders_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderChange) ProtoMessage() {}
+
+func (x *OrderChange) ProtoReflect() protoreflect.Message {
+	mi := &file_params_watch_orders_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderChange.ProtoReflect.Descriptor instead.
+func (*OrderChange) Descriptor() ([]byte, []int) {
+	return file_params_watch_orders_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OrderChange) GetType() OrderChangeType {
+	if x != nil {
+		return x.Type
+	}
+	return OrderChangeType_ORDER_CHANGE_TYPE_UNKNOWN
+}
+
+func (x *OrderChange) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+var File_params_watch_orders_proto protoreflect.FileDescriptor
+
+var file_params_watch_orders_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2f, 0x77, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1f, 0x69, 0x6e, 0x67,
+	0x76, 0x61, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x69, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x1a, 0x12, 0x70, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x55, 0x0a, 0x12,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x3f, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x6e, 0x67, 0x76, 0x61, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x69,
+	0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x6e,
+	0x67, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x22, 0x91, 0x01, 0x0a, 0x0b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x12, 0x44, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x30, 0x2e, 0x69, 0x6e, 0x67, 0x76, 0x61, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x69, 0x73,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67,
+	0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3c, 0x0a, 0x05, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x6e, 0x67, 0x76, 0x61,
+	0x72, 0x6d, 0x61, 0x74, 0x74, 0x69, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x52, 0x05, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x2a, 0xcb, 0x01, 0x0a, 0x0f, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x4f,
+	0x52, 0x44, 0x45, 0x52, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x4f, 0x52,
+	0x44, 0x45, 0x52, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x53, 0x4e, 0x41, 0x50, 0x53, 0x48, 0x4f, 0x54, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x4f, 0x52,
+	0x44, 0x45, 0x52, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x53, 0x59, 0x4e, 0x43, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x4f, 0x52, 0x44, 0x45,
+	0x52, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x52,
+	0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1d, 0x0a, 0x19, 0x4f, 0x52, 0x44, 0x45, 0x52,
+	0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x50, 0x44,
+	0x41, 0x54, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1d, 0x0a, 0x19, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f,
+	0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x4d, 0x4f,
+	0x56, 0x45, 0x44, 0x10, 0x05, 0x42, 0x24, 0x5a, 0x22, 0x2e, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67,
+	0x3b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x67, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_params_watch_orders_proto_rawDescOnce sync.Once
+	file_params_watch_orders_proto_rawDescData = file_params_watch_orders_proto_rawDesc
+)
+
+func file_params_watch_orders_proto_rawDescGZIP() []byte {
+	file_params_watch_orders_proto_rawDescOnce.Do(func() {
+		file_params_watch_orders_proto_rawDescData = protoimpl.X.CompressGZIP(file_params_watch_orders_proto_rawDescData)
+	})
+	return file_params_watch_orders_proto_rawDescData
+}
+
+var file_params_watch_orders_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_params_watch_orders_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_params_watch_orders_proto_goTypes = []any{
+	(OrderChangeType)(0),       // 0: ingvarmattis.services.moving.v1.OrderChangeType
+	(*WatchOrdersRequest)(nil), // 1: ingvarmattis.services.moving.v1.WatchOrdersRequest
+	(*OrderChange)(nil),        // 2: ingvarmattis.services.moving.v1.OrderChange
+	(*Filter)(nil),             // 3: ingvarmattis.services.moving.v1.Filter
+	(*Order)(nil),              // 4: ingvarmattis.services.moving.v1.Order
+}
+var file_params_watch_orders_proto_depIdxs = []int32{
+	3, // 0: ingvarmattis.services.moving.v1.WatchOrdersRequest.Filter:type_name -> ingvarmattis.services.moving.v1.Filter
+	0, // 1: ingvarmattis.services.moving.v1.OrderChange.Type:type_name -> ingvarmattis.services.moving.v1.OrderChangeType
+	4, // 2: ingvarmattis.services.moving.v1.OrderChange.Order:type_name -> ingvarmattis.services.moving.v1.Order
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_params_watch_orders_proto_init() }
+func file_params_watch_orders_proto_init() {
+	if File_params_watch_orders_proto != nil {
+		return
+	}
+	file_params_order_proto_init()
+	file_params_orders_filter_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_params_watch_orders_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_params_watch_orders_proto_goTypes,
+		DependencyIndexes: file_params_watch_orders_proto_depIdxs,
+		EnumInfos:         file_params_watch_orders_proto_enumTypes,
+		MessageInfos:      file_params_watch_orders_proto_msgTypes,
+	}.Build()
+	File_params_watch_orders_proto = out.File
+	file_params_watch_orders_proto_rawDesc = nil
+	file_params_watch_orders_proto_goTypes = nil
+	file_params_watch_orders_proto_depIdxs = nil
+}
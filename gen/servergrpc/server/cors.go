@@ -0,0 +1,59 @@
+package server
+
+import (
+	"net/http"
+	"slices"
+	"sync/atomic"
+)
+
+const anyOrigin = "*"
+
+// corsOrigins are the origins allowed to call the http api from a browser, they are replaced on config reload.
+type corsOrigins struct {
+	origins atomic.Pointer[[]string]
+}
+
+func (c *corsOrigins) set(origins []string) {
+	origins = slices.Clone(origins)
+	c.origins.Store(&origins)
+}
+
+// allowedOrigin returns the value of Access-Control-Allow-Origin for the request origin,
+// it is empty when the origin is not allowed.
+func (c *corsOrigins) allowedOrigin(origin string) string {
+	origins := c.origins.Load()
+	if origins == nil {
+		return ""
+	}
+
+	if slices.Contains(*origins, anyOrigin) {
+		return anyOrigin
+	}
+
+	if origin != "" && slices.Contains(*origins, origin) {
+		return origin
+	}
+
+	return ""
+}
+
+// SetCORSAllowedOrigins replaces the allowed origins, requests in flight keep the old ones.
+func (s *Server) SetCORSAllowedOrigins(origins []string) {
+	s.cors.set(origins)
+}
+
+func (s *Server) writeCORSHeaders(w http.ResponseWriter, r *http.Request) {
+	allowedOrigin := s.cors.allowedOrigin(r.Header.Get("Origin"))
+
+	if allowedOrigin != anyOrigin {
+		w.Header().Add("Vary", "Origin")
+	}
+
+	if allowedOrigin == "" {
+		return
+	}
+
+	w.Header().Set("Access-Control-Allow-Origin", allowedOrigin)
+	w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
+	w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, Idempotency-Key")
+}
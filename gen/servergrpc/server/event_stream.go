@@ -0,0 +1,86 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	// eventStreamContentType is what EventSource sends in Accept, streams are served as server-sent events then.
+	eventStreamContentType = "text/event-stream"
+
+	watchOrdersPath = "/v1/orders/watch"
+
+	// streamTokenName is the query parameter and the cookie with the access token of a browser EventSource,
+	// which cannot set the Authorization header.
+	streamTokenName = "access_token"
+	// streamTokenHeader passes the token to the auth interceptor, which accepts it on WatchOrders only.
+	streamTokenHeader = "Stream-Access-Token"
+)
+
+// moveStreamToken takes the access token of the order watch from the query or a cookie when the request has
+// no Authorization header. The token is removed from the url, so the gateway does not parse it as a filter.
+// The header is dropped on other routes, a client cannot send the token there in any form.
+func moveStreamToken(r *http.Request) {
+	r.Header.Del(streamTokenHeader)
+
+	if r.Method != http.MethodGet || r.URL.Path != watchOrdersPath {
+		return
+	}
+
+	query := r.URL.Query()
+	token := query.Get(streamTokenName)
+
+	if query.Has(streamTokenName) {
+		query.Del(streamTokenName)
+		r.URL.RawQuery = query.Encode()
+	}
+
+	if r.Header.Get("Authorization") != "" {
+		return
+	}
+
+	if token == "" {
+		if cookie, err := r.Cookie(streamTokenName); err == nil {
+			token = cookie.Value
+		}
+	}
+
+	if token != "" {
+		r.Header.Set(streamTokenHeader, token)
+	}
+}
+
+// eventStreamMarshaler writes every gateway stream message as a server-sent event with json data.
+type eventStreamMarshaler struct {
+	runtime.JSONPb
+}
+
+// newEventStreamMarshaler keeps the json of the default gateway marshaler.
+func newEventStreamMarshaler() *eventStreamMarshaler {
+	return &eventStreamMarshaler{JSONPb: runtime.JSONPb{
+		MarshalOptions:   protojson.MarshalOptions{EmitUnpopulated: true},
+		UnmarshalOptions: protojson.UnmarshalOptions{DiscardUnknown: true},
+	}}
+}
+
+func (m *eventStreamMarshaler) ContentType(_ any) string {
+	return eventStreamContentType
+}
+
+// Marshal returns a single data line, protojson output has no line breaks.
+func (m *eventStreamMarshaler) Marshal(v any) ([]byte, error) {
+	data, err := m.JSONPb.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte("data: "), data...), nil
+}
+
+// Delimiter ends the event.
+func (m *eventStreamMarshaler) Delimiter() []byte {
+	return []byte("\n\n")
+}
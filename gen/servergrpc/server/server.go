@@ -4,8 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"net"
 	"net/http"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/go-playground/validator/v10"
@@ -25,8 +28,12 @@ import (
 
 	rpc "github.com/ingvarmattis/moving/gen/servergrpc/moving"
 	"github.com/ingvarmattis/moving/src/infra/utils"
+	"github.com/ingvarmattis/moving/src/transport/apikeys"
 	"github.com/ingvarmattis/moving/src/transport/orders"
 	"github.com/ingvarmattis/moving/src/transport/reviews"
+	"github.com/ingvarmattis/moving/src/transport/telegram"
+	"github.com/ingvarmattis/moving/src/transport/users"
+	"github.com/ingvarmattis/moving/src/transport/webhooks"
 )
 
 const domain = "moving"
@@ -34,6 +41,8 @@ const domain = "moving"
 var (
 	ErrPortNotSpecified = errors.New("port not specified")
 	ErrValidationFailed = errors.New("validation failed")
+
+	errUnknownGranularity = errors.New("unknown stats granularity")
 )
 
 type OrdersGRPCHandlers interface {
@@ -41,10 +50,45 @@ type OrdersGRPCHandlers interface {
 	Orders(ctx context.Context, req *orders.Filter) ([]*orders.Order, error)
 	OrderByID(ctx context.Context, id uint64) (*orders.Order, error)
 	UpdateOrder(ctx context.Context, req *orders.UpdateOrderRequest) error
+	OrdersStats(ctx context.Context, filter *orders.Filter, granularity orders.StatsGranularity) (*orders.Stats, error)
+	WatchOrders(ctx context.Context, filter *orders.Filter, send func(change *orders.OrderChange) error) error
 }
 
 type ReviewsGRPCHandlers interface {
 	Reviews(ctx context.Context) ([]reviews.Review, error)
+	PendingReviews(ctx context.Context) ([]reviews.Review, error)
+	SubmitReview(ctx context.Context, req *reviews.SubmitReviewRequest) (*reviews.Review, error)
+	ApproveReview(ctx context.Context, id uint64) error
+	RejectReview(ctx context.Context, id uint64) error
+}
+
+type WebhooksGRPCHandlers interface {
+	CreateWebhook(ctx context.Context, req *webhooks.CreateWebhookRequest) (*webhooks.Subscription, error)
+	Webhooks(ctx context.Context) ([]webhooks.Subscription, error)
+	DeleteWebhook(ctx context.Context, id uint64) error
+	WebhookDeliveries(ctx context.Context, filter *webhooks.DeliveriesFilter) ([]webhooks.Delivery, error)
+}
+
+type TelegramGRPCHandlers interface {
+	CreateInvite(ctx context.Context) (*telegram.Invite, error)
+	Subscriptions(ctx context.Context) ([]telegram.Subscription, error)
+	Revoke(ctx context.Context, chatID int64) error
+}
+
+type AuthGRPCHandlers interface {
+	Login(ctx context.Context, req *users.LoginRequest) (*users.Session, error)
+	Refresh(ctx context.Context, refreshToken string) (*users.Session, error)
+	Logout(ctx context.Context, refreshToken string) error
+	CreateUser(ctx context.Context, req *users.CreateUserRequest) (*users.User, error)
+	Users(ctx context.Context) ([]users.User, error)
+	DisableUser(ctx context.Context, id uint64) error
+}
+
+type APIKeysGRPCHandlers interface {
+	CreateKey(ctx context.Context, req *apikeys.CreateKeyRequest) (*apikeys.CreatedKey, error)
+	Keys(ctx context.Context) ([]apikeys.Key, error)
+	RevokeKey(ctx context.Context, id uint64) error
+	RotateKey(ctx context.Context, id uint64, req *apikeys.RotateKeyRequest) (*apikeys.CreatedKey, error)
 }
 
 type GRPCErrors interface {
@@ -54,20 +98,34 @@ type GRPCErrors interface {
 type Server struct {
 	rpc.UnimplementedOrdersServiceServer
 	rpc.UnimplementedReviewsServiceServer
-
-	OrdersGRPCHandlers  OrdersGRPCHandlers
-	ReviewsGRPCHandlers ReviewsGRPCHandlers
-
-	NewOrderNotifier func(order *orders.Order)
+	rpc.UnimplementedWebhooksServiceServer
+	rpc.UnimplementedTelegramServiceServer
+	rpc.UnimplementedAuthServiceServer
+	rpc.UnimplementedAPIKeysServiceServer
+
+	OrdersGRPCHandlers   OrdersGRPCHandlers
+	ReviewsGRPCHandlers  ReviewsGRPCHandlers
+	WebhooksGRPCHandlers WebhooksGRPCHandlers
+	TelegramGRPCHandlers TelegramGRPCHandlers
+	AuthGRPCHandlers     AuthGRPCHandlers
+	APIKeysGRPCHandlers  APIKeysGRPCHandlers
+
+	CaptchaVerifier      CaptchaVerifier
+	MinFormFillTime      time.Duration
+	RequireFormStartedAt bool
+	TrustedProxyHops     int
 
 	Validator *validator.Validate
 	Logger    *zap.Logger
 
-	grpcServer *grpc.Server
-	httpServer *runtime.ServeMux
+	grpcServer    *grpc.Server
+	httpServer    *runtime.ServeMux
+	gatewayServer *http.Server
+	healthServer  *health.Server
+	cors          corsOrigins
 }
 
-func (s *Server) Serve(serviceName string, port *int) error {
+func (s *Server) Serve(port *int) error {
 	if port == nil {
 		return ErrPortNotSpecified
 	}
@@ -77,8 +135,6 @@ func (s *Server) Serve(serviceName string, port *int) error {
 		return err
 	}
 
-	s.serveHealthCheck(serviceName)
-
 	s.Logger.Info("starting grpc server", zap.Int("port", *port))
 
 	if err = s.grpcServer.Serve(l); err != nil {
@@ -88,52 +144,72 @@ func (s *Server) Serve(serviceName string, port *int) error {
 	return nil
 }
 
+// ServeHTTP serves the gateway until ShutdownHTTP, it returns http.ErrServerClosed then.
 func (s *Server) ServeHTTP(port *int) error {
 	if port == nil {
 		return ErrPortNotSpecified
 	}
 
+	l, err := net.Listen("tcp", fmt.Sprintf("0.0.0.0:%d", *port))
+	if err != nil {
+		return err
+	}
+
 	s.Logger.Info("starting http server", zap.Int("port", *port))
 
-	if err := http.ListenAndServe(fmt.Sprintf("0.0.0.0:%d", *port),
-		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			// Handle CORS preflight requests
-			if r.Method == "OPTIONS" {
-				w.Header().Set("Access-Control-Allow-Origin", "*")
-				w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-				w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
-				w.Header().Set("Access-Control-Max-Age", "3600")
-				w.WriteHeader(http.StatusNoContent)
-				return
-			}
-
-			// Add CORS headers to all responses
-			w.Header().Set("Access-Control-Allow-Origin", "*")
-			w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-			w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
-
-			if r.ProtoMajor == 2 && r.Header.Get("Content-Type") == "application/grpc" {
-				s.grpcServer.ServeHTTP(w, r)
-				return
-			}
-
-			if len(r.URL.Path) > 1 && r.URL.Path[len(r.URL.Path)-1] == '/' {
-				http.Redirect(w, r, r.URL.Path[:len(r.URL.Path)-1], http.StatusPermanentRedirect)
-				return
-			}
-
-			s.httpServer.ServeHTTP(w, r)
-		})); err != nil {
+	if err = s.gatewayServer.Serve(l); err != nil {
 		return fmt.Errorf("error while serve http | %w", err)
 	}
 
 	return nil
 }
 
-func (s *Server) serveHealthCheck(serviceName string) {
-	healthCheckServer := health.NewServer()
-	grpchealth.RegisterHealthServer(s.grpcServer, healthCheckServer)
-	healthCheckServer.SetServingStatus(serviceName, grpchealth.HealthCheckResponse_SERVING)
+func (s *Server) handleHTTP(w http.ResponseWriter, r *http.Request) {
+	// Add CORS headers to all responses
+	s.writeCORSHeaders(w, r)
+
+	// Handle CORS preflight requests
+	if r.Method == "OPTIONS" {
+		w.Header().Set("Access-Control-Max-Age", "3600")
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	if r.ProtoMajor == 2 && r.Header.Get("Content-Type") == "application/grpc" {
+		s.grpcServer.ServeHTTP(w, r)
+		return
+	}
+
+	if len(r.URL.Path) > 1 && r.URL.Path[len(r.URL.Path)-1] == '/' {
+		http.Redirect(w, r, r.URL.Path[:len(r.URL.Path)-1], http.StatusPermanentRedirect)
+		return
+	}
+
+	moveStreamToken(r)
+
+	s.httpServer.ServeHTTP(w, r)
+}
+
+// ShutdownHTTP stops accepting http requests and waits for the ones in flight,
+// connections still open when ctx is done are closed.
+func (s *Server) ShutdownHTTP(ctx context.Context) error {
+	if err := s.gatewayServer.Shutdown(ctx); err != nil {
+		return errors.Join(err, s.gatewayServer.Close())
+	}
+
+	return nil
+}
+
+// HandleHTTP serves a plain http handler next to the gateway routes, e.g. a provider callback.
+func (s *Server) HandleHTTP(method, path string, handler http.Handler) error {
+	return s.httpServer.HandlePath(method, path, func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// HealthServer serving statuses are kept by the health checker, services start NOT_SERVING.
+func (s *Server) HealthServer() *health.Server {
+	return s.healthServer
 }
 
 func (s *Server) ServeWithCustomListener(l net.Listener) error {
@@ -146,17 +222,50 @@ func (s *Server) ServeWithCustomListener(l net.Listener) error {
 	return nil
 }
 
-func (s *Server) Close() {
-	s.grpcServer.GracefulStop()
+// Services are the registered grpc services with their methods.
+func (s *Server) Services() map[string]grpc.ServiceInfo {
+	return s.grpcServer.GetServiceInfo()
+}
+
+// Shutdown stops accepting grpc calls and waits for the ones in flight,
+// calls still running when ctx is done are cancelled.
+func (s *Server) Shutdown(ctx context.Context) error {
+	stopped := make(chan struct{})
+
+	go func() {
+		s.grpcServer.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		return nil
+	case <-ctx.Done():
+		s.grpcServer.Stop()
+
+		return fmt.Errorf("grpc calls were cancelled | %w", ctx.Err())
+	}
 }
 
 type NewServerOptions struct {
 	ServiceName string
 
-	OrdersGRPCHandlers  OrdersGRPCHandlers
-	ReviewsGRPCHandlers ReviewsGRPCHandlers
+	OrdersGRPCHandlers   OrdersGRPCHandlers
+	ReviewsGRPCHandlers  ReviewsGRPCHandlers
+	WebhooksGRPCHandlers WebhooksGRPCHandlers
+	TelegramGRPCHandlers TelegramGRPCHandlers
+	AuthGRPCHandlers     AuthGRPCHandlers
+	APIKeysGRPCHandlers  APIKeysGRPCHandlers
+
+	CaptchaVerifier      CaptchaVerifier
+	MinFormFillTime      time.Duration
+	RequireFormStartedAt bool
 
-	NewOrderNotifier func(order *orders.Order)
+	// TrustedProxyHops is the number of proxies in front of the service appending to X-Forwarded-For.
+	TrustedProxyHops int
+
+	// CORSAllowedOrigins "*" allows any origin.
+	CORSAllowedOrigins []string
 
 	Logger    *zap.Logger
 	Validator *validator.Validate
@@ -167,6 +276,20 @@ type NewServerOptions struct {
 	ServerOptions []grpc.ServerOption
 }
 
+// forwardedHeaders are passed to grpc metadata as is, other headers follow the gateway defaults.
+var forwardedHeaders = map[string]struct{}{
+	"idempotency-key":     {},
+	"stream-access-token": {},
+}
+
+func incomingHeaderMatcher(key string) (string, bool) {
+	if _, ok := forwardedHeaders[strings.ToLower(key)]; ok {
+		return strings.ToLower(key), true
+	}
+
+	return runtime.DefaultHeaderMatcher(key)
+}
+
 func NewServer(ctx context.Context, grpcPort int, opts *NewServerOptions) *Server {
 	srvOpts := make([]grpc.ServerOption, 0)
 
@@ -178,29 +301,49 @@ func NewServer(ctx context.Context, grpcPort int, opts *NewServerOptions) *Serve
 
 	grpcServer := grpc.NewServer(srvOpts...)
 
-	httpServer := runtime.NewServeMux()
+	httpServer := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher),
+		runtime.WithMarshalerOption(eventStreamContentType, newEventStreamMarshaler()),
+	)
 
 	if opts.Validator == nil {
 		opts.Validator = validator.New()
 	}
 
 	s := Server{
-		UnimplementedOrdersServiceServer:  rpc.UnimplementedOrdersServiceServer{},
-		UnimplementedReviewsServiceServer: rpc.UnimplementedReviewsServiceServer{},
-
-		OrdersGRPCHandlers:  opts.OrdersGRPCHandlers,
-		ReviewsGRPCHandlers: opts.ReviewsGRPCHandlers,
-
-		NewOrderNotifier: opts.NewOrderNotifier,
+		UnimplementedOrdersServiceServer:   rpc.UnimplementedOrdersServiceServer{},
+		UnimplementedReviewsServiceServer:  rpc.UnimplementedReviewsServiceServer{},
+		UnimplementedWebhooksServiceServer: rpc.UnimplementedWebhooksServiceServer{},
+		UnimplementedTelegramServiceServer: rpc.UnimplementedTelegramServiceServer{},
+		UnimplementedAuthServiceServer:     rpc.UnimplementedAuthServiceServer{},
+		UnimplementedAPIKeysServiceServer:  rpc.UnimplementedAPIKeysServiceServer{},
+
+		OrdersGRPCHandlers:   opts.OrdersGRPCHandlers,
+		ReviewsGRPCHandlers:  opts.ReviewsGRPCHandlers,
+		WebhooksGRPCHandlers: opts.WebhooksGRPCHandlers,
+		TelegramGRPCHandlers: opts.TelegramGRPCHandlers,
+		AuthGRPCHandlers:     opts.AuthGRPCHandlers,
+		APIKeysGRPCHandlers:  opts.APIKeysGRPCHandlers,
+
+		CaptchaVerifier:      opts.CaptchaVerifier,
+		MinFormFillTime:      opts.MinFormFillTime,
+		RequireFormStartedAt: opts.RequireFormStartedAt,
+		TrustedProxyHops:     opts.TrustedProxyHops,
 
 		Validator: opts.Validator,
 		Logger:    opts.Logger,
 
-		grpcServer: grpcServer,
-		httpServer: httpServer,
+		grpcServer:   grpcServer,
+		httpServer:   httpServer,
+		healthServer: health.NewServer(),
 	}
 	rpc.RegisterOrdersServiceServer(grpcServer, &s)
 	rpc.RegisterReviewsServiceServer(grpcServer, &s)
+	rpc.RegisterWebhooksServiceServer(grpcServer, &s)
+	rpc.RegisterTelegramServiceServer(grpcServer, &s)
+	rpc.RegisterAuthServiceServer(grpcServer, &s)
+	rpc.RegisterAPIKeysServiceServer(grpcServer, &s)
+	grpchealth.RegisterHealthServer(grpcServer, s.healthServer)
 
 	httpOpts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
 
@@ -216,8 +359,38 @@ func NewServer(ctx context.Context, grpcPort int, opts *NewServerOptions) *Serve
 		panic(err)
 	}
 
+	if err := rpc.RegisterWebhooksServiceHandlerFromEndpoint(
+		ctx, httpServer, fmt.Sprintf("0.0.0.0:%v", grpcPort), httpOpts,
+	); err != nil {
+		panic(err)
+	}
+
+	if err := rpc.RegisterTelegramServiceHandlerFromEndpoint(
+		ctx, httpServer, fmt.Sprintf("0.0.0.0:%v", grpcPort), httpOpts,
+	); err != nil {
+		panic(err)
+	}
+
+	if err := rpc.RegisterAuthServiceHandlerFromEndpoint(
+		ctx, httpServer, fmt.Sprintf("0.0.0.0:%v", grpcPort), httpOpts,
+	); err != nil {
+		panic(err)
+	}
+
+	if err := rpc.RegisterAPIKeysServiceHandlerFromEndpoint(
+		ctx, httpServer, fmt.Sprintf("0.0.0.0:%v", grpcPort), httpOpts,
+	); err != nil {
+		panic(err)
+	}
+
 	reflection.Register(grpcServer)
 
+	s.SetCORSAllowedOrigins(opts.CORSAllowedOrigins)
+	s.gatewayServer = &http.Server{
+		Handler:           http.HandlerFunc(s.handleHTTP),
+		ReadHeaderTimeout: time.Minute,
+	}
+
 	return &s
 }
 
@@ -226,26 +399,30 @@ func (s *Server) CreateOrder(ctx context.Context, req *rpc.CreateOrderRequest) (
 		PropertySize:   orders.PropertySize(req.PropertySize),
 		MoveDate:       req.MoveDate.AsTime(),
 		Name:           req.Name,
-		Email:          req.Email,
+		Email:          utils.PtrIfNotZero(req.GetEmail()),
 		Phone:          req.Phone,
 		MoveFrom:       req.MoveFrom,
 		MoveTo:         req.MoveTo,
 		AdditionalInfo: req.AdditionalInfo,
+		Source:         orders.LeadSource(req.Source),
+		UTMSource:      req.UTMSource,
+		UTMMedium:      req.UTMMedium,
+		UTMCampaign:    req.UTMCampaign,
+		Referrer:       req.Referrer,
+		LandingPage:    req.LandingPage,
 	}
 
 	if err := validate(s.Validator, rpcReq, ErrValidationFailed); err != nil {
 		return nil, err
 	}
 
+	rpcReq.SpamReason = s.spamReason(ctx, req)
+
 	order, err := s.OrdersGRPCHandlers.CreateOrder(ctx, rpcReq)
 	if err != nil {
 		return nil, GRPCUnknownError(err, nil)
 	}
 
-	if s.NewOrderNotifier != nil {
-		s.NewOrderNotifier(order)
-	}
-
 	return &rpc.CreateOrderResponse{Order: &rpc.Order{
 		ID:             order.ID,
 		PropertySize:   utils.PtrIfNotZero(rpc.PropertySize(order.PropertySize)),
@@ -257,71 +434,21 @@ func (s *Server) CreateOrder(ctx context.Context, req *rpc.CreateOrderRequest) (
 		MoveFrom:       &order.MoveFrom,
 		MoveTo:         &order.MoveTo,
 		AdditionalInfo: order.AdditionalInfo,
+		Source:         utils.PtrIfNotZero(rpc.LeadSource(order.Source)),
+		UTMSource:      order.UTMSource,
+		UTMMedium:      order.UTMMedium,
+		UTMCampaign:    order.UTMCampaign,
+		Referrer:       order.Referrer,
+		LandingPage:    order.LandingPage,
+		ArrivalWindow:  utils.PtrIfNotZero(rpc.ArrivalWindow(order.ArrivalWindow)),
+		UpdatedBy:      order.UpdatedBy,
 		CreatedAt:      timestamppb.New(order.CreatedAt),
 		UpdatedAt:      timestamppb.New(order.UpdatedAt),
 	}}, nil
 }
 
 func (s *Server) Orders(ctx context.Context, req *rpc.OrdersRequest) (*rpc.OrdersResponse, error) {
-	reqFilter := req.GetFilter()
-
-	var filter *orders.Filter
-	if reqFilter != nil {
-		var orderStatus *orders.OrderStatus
-		if os := reqFilter.GetOrderStatus(); os != rpc.OrderStatus_ORDER_STATUS_UNKNOWN {
-			oss := orders.OrderStatus(os)
-			orderStatus = &oss
-		}
-
-		var propertySize *orders.PropertySize
-		if ps := reqFilter.GetPropertySize(); ps != rpc.PropertySize_PROPERTY_SIZE_UNKNOWN {
-			pss := orders.PropertySize(ps)
-			propertySize = &pss
-		}
-
-		var createdFrom *time.Time
-		if ts := reqFilter.GetCreatedFrom(); ts != nil {
-			if t := ts.AsTime(); !t.IsZero() {
-				createdFrom = &t
-			}
-		}
-
-		var createdTo *time.Time
-		if ts := reqFilter.GetCreatedTo(); ts != nil {
-			if t := ts.AsTime(); !t.IsZero() {
-				createdTo = &t
-			}
-		}
-
-		var moveDateFrom *time.Time
-		if ts := reqFilter.GetMoveDateFrom(); ts != nil {
-			if t := ts.AsTime(); !t.IsZero() {
-				moveDateFrom = &t
-			}
-		}
-
-		var moveDateTo *time.Time
-		if ts := reqFilter.GetMoveDateTo(); ts != nil {
-			if t := ts.AsTime(); !t.IsZero() {
-				moveDateTo = &t
-			}
-		}
-
-		// Check if all fields are empty
-		if orderStatus == nil && propertySize == nil && createdFrom == nil &&
-			createdTo == nil && moveDateFrom == nil && moveDateTo == nil {
-			filter = nil
-		} else {
-			filter = &orders.Filter{
-				OrderStatus:  orderStatus,
-				PropertySize: propertySize,
-				CreatedFrom:  createdFrom,
-				CreatedTo:    createdTo,
-				MoveDateFrom: moveDateFrom,
-				MoveDateTo:   moveDateTo,
-			}
-		}
-	}
+	filter := toFilter(req.GetFilter())
 
 	rpcOrders, err := s.OrdersGRPCHandlers.Orders(ctx, filter)
 	if err != nil {
@@ -348,6 +475,15 @@ func (s *Server) Orders(ctx context.Context, req *rpc.OrdersRequest) (*rpc.Order
 			MoveFrom:       &order.MoveFrom,
 			MoveTo:         &order.MoveTo,
 			AdditionalInfo: order.AdditionalInfo,
+			Source:         utils.PtrIfNotZero(rpc.LeadSource(order.Source)),
+			UTMSource:      order.UTMSource,
+			UTMMedium:      order.UTMMedium,
+			UTMCampaign:    order.UTMCampaign,
+			Referrer:       order.Referrer,
+			LandingPage:    order.LandingPage,
+			SpamReason:     order.SpamReason,
+			ArrivalWindow:  utils.PtrIfNotZero(rpc.ArrivalWindow(order.ArrivalWindow)),
+			UpdatedBy:      order.UpdatedBy,
 			CreatedAt:      timestamppb.New(order.CreatedAt),
 			UpdatedAt:      timestamppb.New(order.UpdatedAt),
 		})
@@ -356,6 +492,75 @@ func (s *Server) Orders(ctx context.Context, req *rpc.OrdersRequest) (*rpc.Order
 	return &rpc.OrdersResponse{Orders: ordrs}, nil
 }
 
+func toFilter(reqFilter *rpc.Filter) *orders.Filter {
+	if reqFilter == nil {
+		return nil
+	}
+
+	var orderStatus *orders.OrderStatus
+	if os := reqFilter.GetOrderStatus(); os != rpc.OrderStatus_ORDER_STATUS_UNKNOWN {
+		oss := orders.OrderStatus(os)
+		orderStatus = &oss
+	}
+
+	var propertySize *orders.PropertySize
+	if ps := reqFilter.GetPropertySize(); ps != rpc.PropertySize_PROPERTY_SIZE_UNKNOWN {
+		pss := orders.PropertySize(ps)
+		propertySize = &pss
+	}
+
+	var source *orders.LeadSource
+	if ls := reqFilter.GetSource(); ls != rpc.LeadSource_LEAD_SOURCE_UNKNOWN {
+		lss := orders.LeadSource(ls)
+		source = &lss
+	}
+
+	var createdFrom *time.Time
+	if ts := reqFilter.GetCreatedFrom(); ts != nil {
+		if t := ts.AsTime(); !t.IsZero() {
+			createdFrom = &t
+		}
+	}
+
+	var createdTo *time.Time
+	if ts := reqFilter.GetCreatedTo(); ts != nil {
+		if t := ts.AsTime(); !t.IsZero() {
+			createdTo = &t
+		}
+	}
+
+	var moveDateFrom *time.Time
+	if ts := reqFilter.GetMoveDateFrom(); ts != nil {
+		if t := ts.AsTime(); !t.IsZero() {
+			moveDateFrom = &t
+		}
+	}
+
+	var moveDateTo *time.Time
+	if ts := reqFilter.GetMoveDateTo(); ts != nil {
+		if t := ts.AsTime(); !t.IsZero() {
+			moveDateTo = &t
+		}
+	}
+
+	// Check if all fields are empty
+	if orderStatus == nil && propertySize == nil && source == nil && !reqFilter.GetSpam() &&
+		createdFrom == nil && createdTo == nil && moveDateFrom == nil && moveDateTo == nil {
+		return nil
+	}
+
+	return &orders.Filter{
+		OrderStatus:  orderStatus,
+		PropertySize: propertySize,
+		Source:       source,
+		Spam:         reqFilter.GetSpam(),
+		CreatedFrom:  createdFrom,
+		CreatedTo:    createdTo,
+		MoveDateFrom: moveDateFrom,
+		MoveDateTo:   moveDateTo,
+	}
+}
+
 func (s *Server) Order(ctx context.Context, req *rpc.OrderRequest) (*rpc.OrderResponse, error) {
 	rpcOrder, err := s.OrdersGRPCHandlers.OrderByID(ctx, req.ID)
 	if err != nil {
@@ -380,6 +585,15 @@ func (s *Server) Order(ctx context.Context, req *rpc.OrderRequest) (*rpc.OrderRe
 		MoveFrom:       &rpcOrder.MoveFrom,
 		MoveTo:         &rpcOrder.MoveTo,
 		AdditionalInfo: rpcOrder.AdditionalInfo,
+		Source:         utils.PtrIfNotZero(rpc.LeadSource(rpcOrder.Source)),
+		UTMSource:      rpcOrder.UTMSource,
+		UTMMedium:      rpcOrder.UTMMedium,
+		UTMCampaign:    rpcOrder.UTMCampaign,
+		Referrer:       rpcOrder.Referrer,
+		LandingPage:    rpcOrder.LandingPage,
+		SpamReason:     rpcOrder.SpamReason,
+		ArrivalWindow:  utils.PtrIfNotZero(rpc.ArrivalWindow(rpcOrder.ArrivalWindow)),
+		UpdatedBy:      rpcOrder.UpdatedBy,
 		CreatedAt:      timestamppb.New(rpcOrder.CreatedAt),
 		UpdatedAt:      timestamppb.New(rpcOrder.UpdatedAt),
 	}}, nil
@@ -396,6 +610,7 @@ func (s *Server) UpdateOrder(ctx context.Context, req *rpc.UpdateOrderRequest) (
 		MoveFrom:       utils.PtrIfNotZero(req.GetMoveFrom()),
 		MoveTo:         utils.PtrIfNotZero(req.GetMoveTo()),
 		AdditionalInfo: utils.PtrIfNotZero(req.GetAdditionalInfo()),
+		ArrivalWindow:  utils.PtrIfNotZero(orders.ArrivalWindow(req.GetArrivalWindow())),
 	}
 
 	if req.GetMoveDate() != nil {
@@ -407,12 +622,127 @@ func (s *Server) UpdateOrder(ctx context.Context, req *rpc.UpdateOrderRequest) (
 	}
 
 	if err := s.OrdersGRPCHandlers.UpdateOrder(ctx, rpcReq); err != nil {
+		if errors.Is(err, orders.ErrNotFound) {
+			return nil, GRPCNotFoundError(err, nil)
+		}
+
 		return nil, GRPCUnknownError(err, nil)
 	}
 
 	return &emptypb.Empty{}, nil
 }
 
+func (s *Server) OrdersStats(ctx context.Context, req *rpc.OrdersStatsRequest) (*rpc.OrdersStatsResponse, error) {
+	if _, ok := rpc.StatsGranularity_name[int32(req.GetGranularity())]; !ok {
+		return nil, GRPCValidationError(ErrValidationFailed, errUnknownGranularity)
+	}
+
+	stats, err := s.OrdersGRPCHandlers.OrdersStats(
+		ctx, toFilter(req.GetFilter()), orders.StatsGranularity(req.GetGranularity()),
+	)
+	if err != nil {
+		return nil, GRPCUnknownError(err, nil)
+	}
+
+	resp := &rpc.OrdersStatsResponse{
+		Total:          stats.Total,
+		ByOrderStatus:  make([]*rpc.OrderStatusCount, 0, len(stats.ByOrderStatus)),
+		ByPropertySize: make([]*rpc.PropertySizeCount, 0, len(stats.ByPropertySize)),
+		BySource:       make([]*rpc.LeadSourceCount, 0, len(stats.BySource)),
+		ByCreatedAt:    toRPCPeriodCounts(stats.ByCreatedAt),
+		ByMoveDate:     toRPCPeriodCounts(stats.ByMoveDate),
+		ConversionRate: stats.ConversionRate,
+	}
+
+	for _, status := range slices.Sorted(maps.Keys(stats.ByOrderStatus)) {
+		resp.ByOrderStatus = append(resp.ByOrderStatus, &rpc.OrderStatusCount{
+			OrderStatus: rpc.OrderStatus(status),
+			Count:       stats.ByOrderStatus[status],
+		})
+	}
+
+	for _, propertySize := range slices.Sorted(maps.Keys(stats.ByPropertySize)) {
+		resp.ByPropertySize = append(resp.ByPropertySize, &rpc.PropertySizeCount{
+			PropertySize: rpc.PropertySize(propertySize),
+			Count:        stats.ByPropertySize[propertySize],
+		})
+	}
+
+	for _, source := range slices.Sorted(maps.Keys(stats.BySource)) {
+		resp.BySource = append(resp.BySource, &rpc.LeadSourceCount{
+			Source: rpc.LeadSource(source),
+			Count:  stats.BySource[source],
+		})
+	}
+
+	return resp, nil
+}
+
+// WatchOrders ends with Unavailable when changes may have been missed, the client should watch again.
+func (s *Server) WatchOrders(req *rpc.WatchOrdersRequest, stream grpc.ServerStreamingServer[rpc.OrderChange]) error {
+	if err := s.OrdersGRPCHandlers.WatchOrders(
+		stream.Context(), toFilter(req.GetFilter()), func(change *orders.OrderChange) error {
+			return stream.Send(&rpc.OrderChange{
+				Type:  rpc.OrderChangeType(change.Type),
+				Order: toRPCOrder(change.Order),
+			})
+		},
+	); err != nil {
+		if errors.Is(err, orders.ErrWatchInterrupted) {
+			return GRPCUnavailableError(err, nil)
+		}
+
+		return GRPCUnknownError(err, nil)
+	}
+
+	return nil
+}
+
+func toRPCOrder(order *orders.Order) *rpc.Order {
+	if order == nil {
+		return nil
+	}
+
+	propertySize := rpc.PropertySize(order.PropertySize)
+	orderStatus := rpc.OrderStatus(order.OrderStatus)
+
+	return &rpc.Order{
+		ID:             order.ID,
+		PropertySize:   &propertySize,
+		OrderStatus:    &orderStatus,
+		MoveDate:       timestamppb.New(order.MoveDate),
+		Name:           &order.Name,
+		Email:          order.Email,
+		Phone:          &order.Phone,
+		MoveFrom:       &order.MoveFrom,
+		MoveTo:         &order.MoveTo,
+		AdditionalInfo: order.AdditionalInfo,
+		Source:         utils.PtrIfNotZero(rpc.LeadSource(order.Source)),
+		UTMSource:      order.UTMSource,
+		UTMMedium:      order.UTMMedium,
+		UTMCampaign:    order.UTMCampaign,
+		Referrer:       order.Referrer,
+		LandingPage:    order.LandingPage,
+		SpamReason:     order.SpamReason,
+		ArrivalWindow:  utils.PtrIfNotZero(rpc.ArrivalWindow(order.ArrivalWindow)),
+		UpdatedBy:      order.UpdatedBy,
+		CreatedAt:      timestamppb.New(order.CreatedAt),
+		UpdatedAt:      timestamppb.New(order.UpdatedAt),
+	}
+}
+
+func toRPCPeriodCounts(counts []orders.PeriodCount) []*rpc.PeriodCount {
+	result := make([]*rpc.PeriodCount, 0, len(counts))
+	for _, count := range counts {
+		result = append(result, &rpc.PeriodCount{
+			PeriodStart: timestamppb.New(count.PeriodStart),
+			Count:       count.Count,
+		})
+	}
+
+	return result
+}
+
 func (s *Server) Reviews(ctx context.Context, _ *emptypb.Empty) (*rpc.ReviewsResponse, error) {
 	rpcReviews, err := s.ReviewsGRPCHandlers.Reviews(ctx)
 	if err != nil {
@@ -423,18 +753,96 @@ func (s *Server) Reviews(ctx context.Context, _ *emptypb.Empty) (*rpc.ReviewsRes
 		return nil, GRPCUnknownError(err, nil)
 	}
 
-	rvws := make([]*rpc.Review, 0, len(rpcReviews))
-	for _, review := range rpcReviews {
-		rvws = append(rvws, &rpc.Review{
-			Text:     review.Text,
-			Name:     review.Name,
-			Rate:     review.Rate,
-			PhotoURL: review.PhotoURL,
-			URL:      review.URL,
-		})
+	return &rpc.ReviewsResponse{Reviews: toRPCReviews(rpcReviews)}, nil
+}
+
+func (s *Server) PendingReviews(ctx context.Context, _ *emptypb.Empty) (*rpc.ReviewsResponse, error) {
+	rpcReviews, err := s.ReviewsGRPCHandlers.PendingReviews(ctx)
+	if err != nil {
+		// an empty moderation queue is the normal state
+		if errors.Is(err, reviews.ErrNotFound) {
+			return &rpc.ReviewsResponse{}, nil
+		}
+
+		return nil, GRPCUnknownError(err, nil)
 	}
 
-	return &rpc.ReviewsResponse{Reviews: rvws}, nil
+	return &rpc.ReviewsResponse{Reviews: toRPCReviews(rpcReviews)}, nil
+}
+
+func (s *Server) SubmitReview(ctx context.Context, req *rpc.SubmitReviewRequest) (*rpc.SubmitReviewResponse, error) {
+	rpcReq := &reviews.SubmitReviewRequest{
+		OrderID: req.GetOrderID(),
+		Secret:  req.GetSecret(),
+		Name:    req.GetName(),
+		Rate:    req.GetRate(),
+		Text:    req.GetText(),
+	}
+
+	if err := validate(s.Validator, rpcReq, ErrValidationFailed); err != nil {
+		return nil, err
+	}
+
+	review, err := s.ReviewsGRPCHandlers.SubmitReview(ctx, rpcReq)
+	if err != nil {
+		switch {
+		case errors.Is(err, reviews.ErrInvalidSecret):
+			return nil, GRPCPermissionDeniedError(err, nil)
+		case errors.Is(err, reviews.ErrOrderNotDone):
+			return nil, GRPCFailedPreconditionError(err, nil)
+		case errors.Is(err, reviews.ErrAlreadyExists):
+			return nil, GRPCAlreadyExistsError(err, nil)
+		}
+
+		return nil, GRPCUnknownError(err, nil)
+	}
+
+	return &rpc.SubmitReviewResponse{Review: toRPCReview(review)}, nil
+}
+
+func (s *Server) ApproveReview(ctx context.Context, req *rpc.ModerateReviewRequest) (*emptypb.Empty, error) {
+	if err := s.ReviewsGRPCHandlers.ApproveReview(ctx, req.GetID()); err != nil {
+		if errors.Is(err, reviews.ErrNotFound) {
+			return nil, GRPCNotFoundError(err, nil)
+		}
+
+		return nil, GRPCUnknownError(err, nil)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) RejectReview(ctx context.Context, req *rpc.ModerateReviewRequest) (*emptypb.Empty, error) {
+	if err := s.ReviewsGRPCHandlers.RejectReview(ctx, req.GetID()); err != nil {
+		if errors.Is(err, reviews.ErrNotFound) {
+			return nil, GRPCNotFoundError(err, nil)
+		}
+
+		return nil, GRPCUnknownError(err, nil)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func toRPCReviews(rvws []reviews.Review) []*rpc.Review {
+	result := make([]*rpc.Review, 0, len(rvws))
+	for _, review := range rvws {
+		result = append(result, toRPCReview(&review))
+	}
+
+	return result
+}
+
+func toRPCReview(review *reviews.Review) *rpc.Review {
+	return &rpc.Review{
+		ID:       review.ID,
+		Text:     review.Text,
+		Name:     review.Name,
+		Rate:     review.Rate,
+		PhotoURL: review.PhotoURL,
+		URL:      review.URL,
+		Source:   review.Source,
+	}
 }
 
 func GRPCValidationError[T GRPCErrors](reason T, err error) error {
@@ -449,6 +857,26 @@ func GRPCNotFoundError[T GRPCErrors](reason T, err error) error {
 	return gRPCError(codes.NotFound, reason, err)
 }
 
+func GRPCAlreadyExistsError[T GRPCErrors](reason T, err error) error {
+	return gRPCError(codes.AlreadyExists, reason, err)
+}
+
+func GRPCPermissionDeniedError[T GRPCErrors](reason T, err error) error {
+	return gRPCError(codes.PermissionDenied, reason, err)
+}
+
+func GRPCFailedPreconditionError[T GRPCErrors](reason T, err error) error {
+	return gRPCError(codes.FailedPrecondition, reason, err)
+}
+
+func GRPCUnavailableError[T GRPCErrors](reason T, err error) error {
+	return gRPCError(codes.Unavailable, reason, err)
+}
+
+func GRPCUnauthenticatedError[T GRPCErrors](reason T, err error) error {
+	return gRPCError(codes.Unauthenticated, reason, err)
+}
+
 func gRPCError[T GRPCErrors](code codes.Code, reason T, serviceErr error) error {
 	if serviceErr == nil {
 		serviceErr = errors.New("error not set")
@@ -475,3 +903,439 @@ func validate[T GRPCErrors](v *validator.Validate, req any, reason T) error {
 
 	return nil
 }
+
+func (s *Server) CreateWebhook(
+	ctx context.Context, req *rpc.CreateWebhookRequest,
+) (*rpc.CreateWebhookResponse, error) {
+	rpcReq := &webhooks.CreateWebhookRequest{
+		URL:        req.GetURL(),
+		EventTypes: req.GetEventTypes(),
+		Secret:     req.Secret,
+	}
+
+	if err := validate(s.Validator, rpcReq, ErrValidationFailed); err != nil {
+		return nil, err
+	}
+
+	subscription, err := s.WebhooksGRPCHandlers.CreateWebhook(ctx, rpcReq)
+	if err != nil {
+		return nil, GRPCUnknownError(err, nil)
+	}
+
+	return &rpc.CreateWebhookResponse{Subscription: toRPCWebhookSubscription(subscription)}, nil
+}
+
+func (s *Server) Webhooks(ctx context.Context, _ *emptypb.Empty) (*rpc.WebhooksResponse, error) {
+	subscriptions, err := s.WebhooksGRPCHandlers.Webhooks(ctx)
+	if err != nil {
+		if errors.Is(err, webhooks.ErrNotFound) {
+			return nil, GRPCNotFoundError(err, nil)
+		}
+
+		return nil, GRPCUnknownError(err, nil)
+	}
+
+	result := make([]*rpc.WebhookSubscription, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		result = append(result, toRPCWebhookSubscription(&subscription))
+	}
+
+	return &rpc.WebhooksResponse{Subscriptions: result}, nil
+}
+
+func (s *Server) DeleteWebhook(ctx context.Context, req *rpc.DeleteWebhookRequest) (*emptypb.Empty, error) {
+	if err := s.WebhooksGRPCHandlers.DeleteWebhook(ctx, req.GetID()); err != nil {
+		if errors.Is(err, webhooks.ErrNotFound) {
+			return nil, GRPCNotFoundError(err, nil)
+		}
+
+		return nil, GRPCUnknownError(err, nil)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) WebhookDeliveries(
+	ctx context.Context, req *rpc.WebhookDeliveriesRequest,
+) (*rpc.WebhookDeliveriesResponse, error) {
+	filter := &webhooks.DeliveriesFilter{
+		SubscriptionID: req.SubscriptionID,
+		Limit:          req.GetLimit(),
+	}
+
+	if req.Status != nil {
+		status := fromRPCWebhookDeliveryStatus(req.GetStatus())
+		filter.Status = &status
+	}
+
+	if err := validate(s.Validator, filter, ErrValidationFailed); err != nil {
+		return nil, err
+	}
+
+	deliveries, err := s.WebhooksGRPCHandlers.WebhookDeliveries(ctx, filter)
+	if err != nil {
+		if errors.Is(err, webhooks.ErrNotFound) {
+			return nil, GRPCNotFoundError(err, nil)
+		}
+
+		return nil, GRPCUnknownError(err, nil)
+	}
+
+	result := make([]*rpc.WebhookDelivery, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		var responseStatus *int32
+		if delivery.ResponseStatus != nil {
+			status := int32(*delivery.ResponseStatus)
+			responseStatus = &status
+		}
+
+		var deliveredAt *timestamppb.Timestamp
+		if delivery.DeliveredAt != nil {
+			deliveredAt = timestamppb.New(*delivery.DeliveredAt)
+		}
+
+		result = append(result, &rpc.WebhookDelivery{
+			ID:             delivery.ID,
+			SubscriptionID: delivery.SubscriptionID,
+			EventType:      delivery.EventType,
+			OrderID:        delivery.OrderID,
+			Status:         toRPCWebhookDeliveryStatus(delivery.Status),
+			Attempts:       uint32(delivery.Attempts),
+			ResponseStatus: responseStatus,
+			LastError:      delivery.LastError,
+			NextAttemptAt:  timestamppb.New(delivery.NextAttemptAt),
+			CreatedAt:      timestamppb.New(delivery.CreatedAt),
+			DeliveredAt:    deliveredAt,
+		})
+	}
+
+	return &rpc.WebhookDeliveriesResponse{Deliveries: result}, nil
+}
+
+func (s *Server) CreateTelegramInvite(
+	ctx context.Context, _ *emptypb.Empty,
+) (*rpc.CreateTelegramInviteResponse, error) {
+	invite, err := s.TelegramGRPCHandlers.CreateInvite(ctx)
+	if err != nil {
+		return nil, GRPCUnknownError(err, nil)
+	}
+
+	return &rpc.CreateTelegramInviteResponse{Invite: &rpc.TelegramInvite{
+		Code:      invite.Code,
+		ExpiresAt: timestamppb.New(invite.ExpiresAt),
+	}}, nil
+}
+
+func (s *Server) TelegramChats(ctx context.Context, _ *emptypb.Empty) (*rpc.TelegramChatsResponse, error) {
+	subscriptions, err := s.TelegramGRPCHandlers.Subscriptions(ctx)
+	if err != nil {
+		if errors.Is(err, telegram.ErrNotFound) {
+			return nil, GRPCNotFoundError(err, nil)
+		}
+
+		return nil, GRPCUnknownError(err, nil)
+	}
+
+	chats := make([]*rpc.TelegramChat, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		chats = append(chats, &rpc.TelegramChat{
+			ChatID:        subscription.ChatID,
+			Title:         subscription.Title,
+			NewOrders:     subscription.Preferences.NewOrders,
+			StatusChanges: subscription.Preferences.StatusChanges,
+			Digests:       subscription.Preferences.Digests,
+			CreatedAt:     timestamppb.New(subscription.CreatedAt),
+		})
+	}
+
+	return &rpc.TelegramChatsResponse{Chats: chats}, nil
+}
+
+func (s *Server) RevokeTelegramChat(ctx context.Context, req *rpc.RevokeTelegramChatRequest) (*emptypb.Empty, error) {
+	if err := s.TelegramGRPCHandlers.Revoke(ctx, req.GetChatID()); err != nil {
+		if errors.Is(err, telegram.ErrNotFound) {
+			return nil, GRPCNotFoundError(err, nil)
+		}
+
+		return nil, GRPCUnknownError(err, nil)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func toRPCWebhookSubscription(subscription *webhooks.Subscription) *rpc.WebhookSubscription {
+	return &rpc.WebhookSubscription{
+		ID:         subscription.ID,
+		URL:        subscription.URL,
+		EventTypes: subscription.EventTypes,
+		Secret:     subscription.Secret,
+		CreatedAt:  timestamppb.New(subscription.CreatedAt),
+	}
+}
+
+func toRPCWebhookDeliveryStatus(status string) rpc.WebhookDeliveryStatus {
+	switch status {
+	case webhooks.DeliveryStatusPending:
+		return rpc.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING
+	case webhooks.DeliveryStatusDelivered:
+		return rpc.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DELIVERED
+	case webhooks.DeliveryStatusFailed:
+		return rpc.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_FAILED
+	default:
+		return rpc.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNKNOWN
+	}
+}
+
+// fromRPCWebhookDeliveryStatus returns an empty string for unknown statuses, validation rejects it.
+func fromRPCWebhookDeliveryStatus(status rpc.WebhookDeliveryStatus) string {
+	switch status {
+	case rpc.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING:
+		return webhooks.DeliveryStatusPending
+	case rpc.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DELIVERED:
+		return webhooks.DeliveryStatusDelivered
+	case rpc.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_FAILED:
+		return webhooks.DeliveryStatusFailed
+	default:
+		return ""
+	}
+}
+
+func (s *Server) Login(ctx context.Context, req *rpc.LoginRequest) (*rpc.LoginResponse, error) {
+	rpcReq := &users.LoginRequest{Email: req.GetEmail(), Password: req.GetPassword()}
+
+	if err := validate(s.Validator, rpcReq, ErrValidationFailed); err != nil {
+		return nil, err
+	}
+
+	session, err := s.AuthGRPCHandlers.Login(ctx, rpcReq)
+	if err != nil {
+		if errors.Is(err, users.ErrInvalidCredentials) {
+			return nil, GRPCUnauthenticatedError(err, nil)
+		}
+
+		if errors.Is(err, users.ErrLoginDisabled) {
+			return nil, GRPCFailedPreconditionError(err, nil)
+		}
+
+		return nil, GRPCUnknownError(err, nil)
+	}
+
+	return &rpc.LoginResponse{Session: toRPCSession(session)}, nil
+}
+
+func (s *Server) RefreshToken(ctx context.Context, req *rpc.RefreshTokenRequest) (*rpc.RefreshTokenResponse, error) {
+	session, err := s.AuthGRPCHandlers.Refresh(ctx, req.GetRefreshToken())
+	if err != nil {
+		if errors.Is(err, users.ErrInvalidRefreshToken) {
+			return nil, GRPCUnauthenticatedError(err, nil)
+		}
+
+		if errors.Is(err, users.ErrLoginDisabled) {
+			return nil, GRPCFailedPreconditionError(err, nil)
+		}
+
+		return nil, GRPCUnknownError(err, nil)
+	}
+
+	return &rpc.RefreshTokenResponse{Session: toRPCSession(session)}, nil
+}
+
+func (s *Server) Logout(ctx context.Context, req *rpc.LogoutRequest) (*emptypb.Empty, error) {
+	if err := s.AuthGRPCHandlers.Logout(ctx, req.GetRefreshToken()); err != nil {
+		return nil, GRPCUnknownError(err, nil)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) CreateAdminUser(
+	ctx context.Context, req *rpc.CreateAdminUserRequest,
+) (*rpc.CreateAdminUserResponse, error) {
+	rpcReq := &users.CreateUserRequest{
+		Email:    req.GetEmail(),
+		Name:     req.GetName(),
+		Password: req.GetPassword(),
+		Roles:    req.GetRoles(),
+	}
+
+	if err := validate(s.Validator, rpcReq, ErrValidationFailed); err != nil {
+		return nil, err
+	}
+
+	user, err := s.AuthGRPCHandlers.CreateUser(ctx, rpcReq)
+	if err != nil {
+		if errors.Is(err, users.ErrAlreadyExists) {
+			return nil, GRPCAlreadyExistsError(err, nil)
+		}
+
+		return nil, GRPCUnknownError(err, nil)
+	}
+
+	return &rpc.CreateAdminUserResponse{User: toRPCAdminUser(user)}, nil
+}
+
+func (s *Server) AdminUsers(ctx context.Context, _ *emptypb.Empty) (*rpc.AdminUsersResponse, error) {
+	adminUsers, err := s.AuthGRPCHandlers.Users(ctx)
+	if err != nil {
+		if errors.Is(err, users.ErrNotFound) {
+			return nil, GRPCNotFoundError(err, nil)
+		}
+
+		return nil, GRPCUnknownError(err, nil)
+	}
+
+	result := make([]*rpc.AdminUser, 0, len(adminUsers))
+	for _, user := range adminUsers {
+		result = append(result, toRPCAdminUser(&user))
+	}
+
+	return &rpc.AdminUsersResponse{Users: result}, nil
+}
+
+func (s *Server) DisableAdminUser(ctx context.Context, req *rpc.DisableAdminUserRequest) (*emptypb.Empty, error) {
+	if err := s.AuthGRPCHandlers.DisableUser(ctx, req.GetID()); err != nil {
+		if errors.Is(err, users.ErrNotFound) {
+			return nil, GRPCNotFoundError(err, nil)
+		}
+
+		return nil, GRPCUnknownError(err, nil)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func toRPCSession(session *users.Session) *rpc.Session {
+	return &rpc.Session{
+		AccessToken:      session.AccessToken,
+		AccessExpiresAt:  timestamppb.New(session.AccessExpiresAt),
+		RefreshToken:     session.RefreshToken,
+		RefreshExpiresAt: timestamppb.New(session.RefreshExpiresAt),
+		User:             toRPCAdminUser(&session.User),
+	}
+}
+
+func toRPCAdminUser(user *users.User) *rpc.AdminUser {
+	return &rpc.AdminUser{
+		ID:        user.ID,
+		Email:     user.Email,
+		Name:      user.Name,
+		Roles:     user.Roles,
+		CreatedAt: timestamppb.New(user.CreatedAt),
+		Disabled:  user.Disabled,
+	}
+}
+
+func (s *Server) CreateAPIKey(ctx context.Context, req *rpc.CreateAPIKeyRequest) (*rpc.CreateAPIKeyResponse, error) {
+	rpcReq := &apikeys.CreateKeyRequest{
+		Name:      req.GetName(),
+		Scopes:    req.GetScopes(),
+		ExpiresAt: fromRPCTimestamp(req.ExpiresAt),
+	}
+
+	if err := validate(s.Validator, rpcReq, ErrValidationFailed); err != nil {
+		return nil, err
+	}
+
+	key, err := s.APIKeysGRPCHandlers.CreateKey(ctx, rpcReq)
+	if err != nil {
+		if errors.Is(err, apikeys.ErrScopeNotHeld) {
+			return nil, GRPCPermissionDeniedError(err, nil)
+		}
+
+		return nil, GRPCUnknownError(err, nil)
+	}
+
+	return &rpc.CreateAPIKeyResponse{Key: toRPCCreatedAPIKey(key)}, nil
+}
+
+func (s *Server) APIKeys(ctx context.Context, _ *emptypb.Empty) (*rpc.APIKeysResponse, error) {
+	keys, err := s.APIKeysGRPCHandlers.Keys(ctx)
+	if err != nil {
+		if errors.Is(err, apikeys.ErrNotFound) {
+			return nil, GRPCNotFoundError(err, nil)
+		}
+
+		return nil, GRPCUnknownError(err, nil)
+	}
+
+	result := make([]*rpc.APIKey, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, toRPCAPIKey(&key))
+	}
+
+	return &rpc.APIKeysResponse{Keys: result}, nil
+}
+
+func (s *Server) RevokeAPIKey(ctx context.Context, req *rpc.RevokeAPIKeyRequest) (*emptypb.Empty, error) {
+	if err := s.APIKeysGRPCHandlers.RevokeKey(ctx, req.GetID()); err != nil {
+		if errors.Is(err, apikeys.ErrNotFound) {
+			return nil, GRPCNotFoundError(err, nil)
+		}
+
+		return nil, GRPCUnknownError(err, nil)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) RotateAPIKey(ctx context.Context, req *rpc.RotateAPIKeyRequest) (*rpc.RotateAPIKeyResponse, error) {
+	rpcReq := &apikeys.RotateKeyRequest{ExpiresAt: fromRPCTimestamp(req.ExpiresAt)}
+	if req.GracePeriod != nil {
+		gracePeriod := req.GetGracePeriod().AsDuration()
+		rpcReq.GracePeriod = &gracePeriod
+	}
+
+	if err := validate(s.Validator, rpcReq, ErrValidationFailed); err != nil {
+		return nil, err
+	}
+
+	key, err := s.APIKeysGRPCHandlers.RotateKey(ctx, req.GetID(), rpcReq)
+	if err != nil {
+		switch {
+		case errors.Is(err, apikeys.ErrNotFound):
+			return nil, GRPCNotFoundError(err, nil)
+		case errors.Is(err, apikeys.ErrScopeNotHeld):
+			return nil, GRPCPermissionDeniedError(err, nil)
+		}
+
+		return nil, GRPCUnknownError(err, nil)
+	}
+
+	return &rpc.RotateAPIKeyResponse{Key: toRPCCreatedAPIKey(key)}, nil
+}
+
+func toRPCCreatedAPIKey(key *apikeys.CreatedKey) *rpc.CreatedAPIKey {
+	return &rpc.CreatedAPIKey{Key: toRPCAPIKey(&key.Key), Secret: key.Secret}
+}
+
+func toRPCAPIKey(key *apikeys.Key) *rpc.APIKey {
+	return &rpc.APIKey{
+		ID:         key.ID,
+		Name:       key.Name,
+		Prefix:     key.Prefix,
+		Scopes:     key.Scopes,
+		ExpiresAt:  toRPCTimestamp(key.ExpiresAt),
+		LastUsedAt: toRPCTimestamp(key.LastUsedAt),
+		CreatedBy:  key.CreatedBy,
+		CreatedAt:  timestamppb.New(key.CreatedAt),
+		RevokedAt:  toRPCTimestamp(key.RevokedAt),
+	}
+}
+
+func toRPCTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+
+	return timestamppb.New(*t)
+}
+
+func fromRPCTimestamp(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+
+	t := ts.AsTime()
+
+	return &t
+}
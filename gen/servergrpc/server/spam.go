@@ -0,0 +1,56 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	rpc "github.com/ingvarmattis/moving/gen/servergrpc/moving"
+	"github.com/ingvarmattis/moving/src/infra/utils"
+)
+
+const (
+	SpamReasonHoneypot = "honeypot"
+	SpamReasonTooFast  = "too_fast"
+	SpamReasonCaptcha  = "captcha"
+)
+
+type CaptchaVerifier interface {
+	Verify(ctx context.Context, token, remoteIP string) (bool, error)
+}
+
+// spamReason returns nil for orders which look like they were sent by a human.
+// Flagged orders are still stored, so nothing is lost if a check misfires.
+// The reason is not returned to the client, so a bot cannot learn which check it failed.
+func (s *Server) spamReason(ctx context.Context, req *rpc.CreateOrderRequest) *string {
+	if req.GetHoneypot() != "" {
+		return utils.PtrIfNotZero(SpamReasonHoneypot)
+	}
+
+	if startedAt := req.GetFormStartedAt(); startedAt != nil && s.MinFormFillTime > 0 {
+		if time.Since(startedAt.AsTime()) < s.MinFormFillTime {
+			return utils.PtrIfNotZero(SpamReasonTooFast)
+		}
+	}
+
+	// older clients and orders entered through the api do not send the start time, so it is opt-in
+	if req.GetFormStartedAt() == nil && s.RequireFormStartedAt {
+		return utils.PtrIfNotZero(SpamReasonTooFast)
+	}
+
+	if s.CaptchaVerifier != nil {
+		ok, err := s.CaptchaVerifier.Verify(ctx, req.GetCaptchaToken(), utils.ClientIP(ctx, s.TrustedProxyHops))
+		if err != nil {
+			// the provider is unavailable, do not punish real customers for it
+			s.Logger.Error("failed to verify captcha", zap.Error(err))
+			return nil
+		}
+
+		if !ok {
+			return utils.PtrIfNotZero(SpamReasonCaptcha)
+		}
+	}
+
+	return nil
+}
@@ -1,41 +1,111 @@
 package server
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"html"
+	"net/http"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"go.uber.org/zap"
 	"gopkg.in/telebot.v4"
-	"gopkg.in/telebot.v4/middleware"
 
+	"github.com/ingvarmattis/moving/src/infra/tenant"
 	"github.com/ingvarmattis/moving/src/transport/orders"
+	"github.com/ingvarmattis/moving/src/transport/telegram"
 )
 
 type NoopTelegramBot struct{}
 
-func (NoopTelegramBot) NotifyNewOrder(*orders.Order) {}
+func (NoopTelegramBot) NotifyNewOrder(context.Context, *orders.Order) error { return nil }
+func (NoopTelegramBot) NotifyStatusChange(context.Context, *orders.Order, string) error {
+	return nil
+}
+func (NoopTelegramBot) RunDigests(context.Context)   {}
+func (NoopTelegramBot) UpdatesHandler() http.Handler { return http.NotFoundHandler() }
 func (NoopTelegramBot) Start()                       {}
 func (NoopTelegramBot) Close()                       {}
+func (NoopTelegramBot) Check(context.Context) error  { return nil }
 
 // NewNoopTelegramBot returns a no-op telegram bot struct.
 func NewNoopTelegramBot() *NoopTelegramBot {
 	return &NoopTelegramBot{}
 }
 
+var errPollerStopped = errors.New("telegram updates poller is not running")
+
+type notificationsMetrics interface {
+	TelegramNotification(err error)
+}
+
+// TelegramOrdersHandlers is what the bot commands need from orders.
+type TelegramOrdersHandlers interface {
+	Orders(ctx context.Context, filter *orders.Filter) ([]*orders.Order, error)
+	OrderByID(ctx context.Context, id uint64) (*orders.Order, error)
+	UpdateOrder(ctx context.Context, req *orders.UpdateOrderRequest) error
+}
+
+// TelegramSubscriptionsHandlers authorizes chats and keeps what they are notified about.
+type TelegramSubscriptionsHandlers interface {
+	Subscribe(ctx context.Context, code string, chatID int64, title string) (*telegram.Subscription, error)
+	Subscription(ctx context.Context, chatID int64) (*telegram.Subscription, error)
+	ChatIDs(ctx context.Context, event string) ([]int64, error)
+	UpdatePreferences(ctx context.Context, chatID int64, preferences *telegram.Preferences) error
+	ClaimDigest(ctx context.Context, digest string, scheduledAt time.Time) (bool, error)
+}
+
+// TelegramTenants every tenant has its own chats, they are notified about orders of their tenant only.
+type TelegramTenants interface {
+	TenantByID(ctx context.Context, id uint64) (*tenant.Tenant, error)
+	Tenants(ctx context.Context) ([]*tenant.Tenant, error)
+}
+
 type TelegramBot struct {
-	tb             *telebot.Bot
-	logger         *zap.Logger
-	allowedChatIDs []int64
+	tb            *telebot.Bot
+	logger        *zap.Logger
+	metrics       notificationsMetrics
+	orders        TelegramOrdersHandlers
+	subscriptions TelegramSubscriptionsHandlers
+	tenants       TelegramTenants
+	location      *time.Location
+	digests       DigestSchedule
+	webhookSecret string
+
+	started atomic.Bool
+	running atomic.Bool
 }
 
-func NewTelegramBot(
-	logger *zap.Logger, token string, timeout time.Duration, allowedChatIDs []int64,
-) (*TelegramBot, error) {
+// TelegramBotOptions Location is used to tell what today is and when digests are due.
+// Updates are long polled unless Webhook is set.
+type TelegramBotOptions struct {
+	Token    string
+	Timeout  time.Duration
+	Webhook  *TelegramWebhook
+	Location *time.Location
+	Digests  DigestSchedule
+
+	OrdersHandlers        TelegramOrdersHandlers
+	SubscriptionsHandlers TelegramSubscriptionsHandlers
+	Tenants               TelegramTenants
+	Metrics               notificationsMetrics
+	Logger                *zap.Logger
+}
+
+func NewTelegramBot(opts *TelegramBotOptions) (*TelegramBot, error) {
+	var poller telebot.Poller = &telebot.LongPoller{Timeout: opts.Timeout}
+	if opts.Webhook != nil {
+		poller = &webhookPoller{webhook: &telebot.Webhook{
+			SecretToken: opts.Webhook.Secret,
+			Endpoint:    &telebot.WebhookEndpoint{PublicURL: opts.Webhook.PublicURL},
+		}, logger: opts.Logger}
+	}
+
 	pref := telebot.Settings{
-		Token:  token,
-		Poller: &telebot.LongPoller{Timeout: timeout},
+		Token:  opts.Token,
+		Poller: poller,
 	}
 
 	tBot, err := telebot.NewBot(pref)
@@ -43,17 +113,32 @@ func NewTelegramBot(
 		return nil, fmt.Errorf("failed to create telegram bot | %w", err)
 	}
 
-	tBot.Use(middleware.Whitelist(allowedChatIDs...))
+	location := opts.Location
+	if location == nil {
+		location = time.UTC
+	}
 
 	bot := &TelegramBot{
-		tb:             tBot,
-		logger:         logger,
-		allowedChatIDs: allowedChatIDs,
+		tb:            tBot,
+		logger:        opts.Logger,
+		metrics:       opts.Metrics,
+		orders:        opts.OrdersHandlers,
+		subscriptions: opts.SubscriptionsHandlers,
+		tenants:       opts.Tenants,
+		location:      location,
+		digests:       opts.Digests,
 	}
 
+	if opts.Webhook != nil {
+		bot.webhookSecret = opts.Webhook.Secret
+	}
+
+	tBot.Use(bot.authMiddleware())
 	tBot.Use(bot.loggingMiddleware())
 
-	tBot.Handle("/start", bot.onStart)
+	bot.handleSubscriptionCommands()
+	bot.handleOrderCommands()
+	bot.handleOrderStatusButtons()
 
 	return bot, nil
 }
@@ -85,39 +170,89 @@ func (b *TelegramBot) loggingMiddleware() func(telebot.HandlerFunc) telebot.Hand
 	}
 }
 
-func (b *TelegramBot) onStart(ctx telebot.Context) error {
-	return ctx.Send("You have subscribed to updates.")
-}
-
 func (b *TelegramBot) Start() {
 	b.logger.Info("starting telegram bot")
+
+	b.started.Store(true)
+	b.running.Store(true)
+	defer b.running.Store(false)
+
 	b.tb.Start()
 }
 
+// Check fails when the updates poller returned or telegram does not answer for the bot token.
+func (b *TelegramBot) Check(context.Context) error {
+	if b.started.Load() && !b.running.Load() {
+		return errPollerStopped
+	}
+
+	if _, err := b.tb.Raw("getMe", nil); err != nil {
+		return fmt.Errorf("failed to reach telegram | %w", err)
+	}
+
+	return nil
+}
+
 func (b *TelegramBot) Close() {
 	b.tb.Stop()
 	_, _ = b.tb.Close()
 }
 
-// NotifyNewOrder sends the new order details to all allowed chats.
-func (b *TelegramBot) NotifyNewOrder(order *orders.Order) {
-	text := formatOrderMessage(order)
-	opts := &telebot.SendOptions{ParseMode: telebot.ModeHTML}
-	for _, chatID := range b.allowedChatIDs {
+// NotifyNewOrder sends the new order details with status buttons to chats of the tenant in ctx
+// subscribed to new orders.
+// It returns the joined errors of failed chats, so the caller may retry.
+func (b *TelegramBot) NotifyNewOrder(ctx context.Context, order *orders.Order) error {
+	return b.notify(ctx, telegram.EventNewOrders, order, formatOrderMessage("NEW ORDER", order))
+}
+
+// NotifyStatusChange tells chats subscribed to status changes about the order new status.
+func (b *TelegramBot) NotifyStatusChange(ctx context.Context, order *orders.Order, previousStatus string) error {
+	text := formatOrderMessage(orderHeading(order), order) + fmt.Sprintf(
+		"\n\n<i>Status changed from %s to %s</i>", html.EscapeString(previousStatus), orderStatusNames[order.OrderStatus],
+	)
+
+	return b.notify(ctx, telegram.EventStatusChanges, order, text)
+}
+
+func (b *TelegramBot) notify(ctx context.Context, event string, order *orders.Order, text string) error {
+	chatIDs, err := b.subscriptions.ChatIDs(ctx, event)
+	if err != nil {
+		return fmt.Errorf("failed to get subscribed chats | %w", err)
+	}
+
+	opts := &telebot.SendOptions{ParseMode: telebot.ModeHTML, ReplyMarkup: orderStatusMarkup(order)}
+
+	var errs []error
+	for _, chatID := range chatIDs {
 		recipient := &telebot.Chat{ID: chatID}
-		if _, err := b.tb.Send(recipient, text, opts); err != nil {
-			b.logger.Error("send new order to telegram", zap.Error(err), zap.Int64("chat_id", chatID), zap.Uint64("order_id", order.ID))
+		_, err := b.tb.Send(recipient, text, opts)
+		b.metrics.TelegramNotification(err)
+
+		if err != nil {
+			b.logger.Error("send order to telegram",
+				zap.Error(err), zap.String("event", event), zap.Int64("chat_id", chatID), zap.Uint64("order_id", order.ID),
+			)
+			errs = append(errs, fmt.Errorf("chat %d | %w", chatID, err))
 		}
 	}
+
+	return errors.Join(errs...)
 }
 
-func formatOrderMessage(o *orders.Order) string {
+func formatOrderMessage(heading string, o *orders.Order) string {
 	escape := func(s string) string { return html.EscapeString(s) }
 
 	var b strings.Builder
-	b.WriteString("<b>NEW ORDER</b> #")
+	b.WriteString("<b>")
+	b.WriteString(escape(heading))
+	b.WriteString("</b> #")
 	b.WriteString(fmt.Sprint(o.ID))
 	b.WriteString("\n\n")
+	if o.Name != "" {
+		b.WriteString("<b>Name:</b> ")
+		b.WriteString(escape(o.Name))
+		b.WriteString("\n")
+	}
 	b.WriteString("<b>From:</b> ")
 	b.WriteString(escape(o.MoveFrom))
 	b.WriteString("\n<b>To:</b> ")
@@ -133,6 +268,11 @@ func formatOrderMessage(o *orders.Order) string {
 		b.WriteString(escape(o.Phone))
 		b.WriteString("\n")
 	}
+	if name, ok := arrivalWindowName(o.ArrivalWindow); ok {
+		b.WriteString("<b>Arrival:</b> ")
+		b.WriteString(name)
+		b.WriteString("\n")
+	}
 	if o.Email != nil && *o.Email != "" {
 		b.WriteString("<b>Email:</b> ")
 		b.WriteString(escape(*o.Email))
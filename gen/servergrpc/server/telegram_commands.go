@@ -0,0 +1,285 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"go.uber.org/zap"
+	"gopkg.in/telebot.v4"
+
+	"github.com/ingvarmattis/moving/src/transport/orders"
+)
+
+const (
+	ordersPageSize = 5
+	commandTimeout = 10 * time.Second
+
+	// maxSearchLength keeps the search text inside the 64 bytes of the page buttons callback data.
+	maxSearchLength = 40
+	// maxListedInfoLength shortens descriptions in lists, so a page fits into one message.
+	maxListedInfoLength = 200
+
+	ordersPageUnique = "orders_page"
+
+	ordersQueryStatus = "s"
+	ordersQueryToday  = "t"
+	ordersQuerySearch = "q"
+)
+
+var orderStatusNames = map[orders.OrderStatus]string{
+	orders.OrderStatusCreated:    "created",
+	orders.OrderStatusRejected:   "rejected",
+	orders.OrderStatusInProgress: "in_progress",
+	orders.OrderStatusDone:       "done",
+}
+
+const ordersUsage = "Commands:\n" +
+	"/orders [created|rejected|in_progress|done] - list orders\n" +
+	"/order <id> - show an order\n" +
+	"/today - moves scheduled for today\n" +
+	"/search <text> - find orders by name, phone, email or address\n" +
+	"/settings - choose notifications of this chat\n" +
+	"/stop - stop notifications, /start turns them on again"
+
+// ordersQuery is a list command, it is carried by the page buttons to fetch other pages.
+type ordersQuery struct {
+	kind string
+	arg  string
+	page int
+}
+
+func (q ordersQuery) data(page int) []string {
+	return []string{q.kind, q.arg, strconv.Itoa(page)}
+}
+
+func parseOrdersQuery(data string) (ordersQuery, error) {
+	parts := strings.SplitN(data, "|", 3)
+	if len(parts) != 3 {
+		return ordersQuery{}, fmt.Errorf("invalid orders page data %q", data)
+	}
+
+	page, err := strconv.Atoi(parts[2])
+	if err != nil || page < 0 {
+		return ordersQuery{}, fmt.Errorf("invalid orders page %q", parts[2])
+	}
+
+	return ordersQuery{kind: parts[0], arg: parts[1], page: page}, nil
+}
+
+func (b *TelegramBot) handleOrderCommands() {
+	b.tb.Handle("/help", b.onHelp)
+	b.tb.Handle("/orders", b.onOrders)
+	b.tb.Handle("/order", b.onOrder)
+	b.tb.Handle("/today", b.onToday)
+	b.tb.Handle("/search", b.onSearch)
+	b.tb.Handle(&telebot.Btn{Unique: ordersPageUnique}, b.onOrdersPage)
+}
+
+func (b *TelegramBot) onHelp(c telebot.Context) error {
+	return c.Send(ordersUsage)
+}
+
+func (b *TelegramBot) onOrders(c telebot.Context) error {
+	query := ordersQuery{kind: ordersQueryStatus}
+
+	if args := c.Args(); len(args) > 0 {
+		if _, ok := orderStatusByName(args[0]); !ok {
+			return c.Send("Unknown status, use one of: created, rejected, in_progress, done.")
+		}
+
+		query.arg = args[0]
+	}
+
+	return b.sendOrders(c, query, false)
+}
+
+func (b *TelegramBot) onOrder(c telebot.Context) error {
+	args := c.Args()
+	if len(args) != 1 {
+		return c.Send("Usage: /order <id>")
+	}
+
+	id, err := strconv.ParseUint(strings.TrimPrefix(args[0], "#"), 10, 64)
+	if err != nil {
+		return c.Send("Order id must be a number.")
+	}
+
+	ctx, cancel := b.commandContext(c)
+	defer cancel()
+
+	order, err := b.orders.OrderByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, orders.ErrNotFound) {
+			return c.Send(fmt.Sprintf("Order #%d not found.", id))
+		}
+
+		b.logger.Error("failed to get order for telegram", zap.Error(err), zap.Uint64("order_id", id))
+
+		return c.Send("Failed to load the order, try again later.")
+	}
+
+	return c.Send(formatOrderMessage(orderHeading(order), order), &telebot.SendOptions{
+		ParseMode:   telebot.ModeHTML,
+		ReplyMarkup: orderStatusMarkup(order),
+	})
+}
+
+func (b *TelegramBot) onToday(c telebot.Context) error {
+	return b.sendOrders(c, ordersQuery{kind: ordersQueryToday}, false)
+}
+
+func (b *TelegramBot) onSearch(c telebot.Context) error {
+	text := strings.TrimSpace(c.Message().Payload)
+	if text == "" {
+		return c.Send("Usage: /search <text>")
+	}
+
+	if len(text) > maxSearchLength || strings.Contains(text, "|") {
+		return c.Send(fmt.Sprintf("Search text must be up to %d characters without \"|\".", maxSearchLength))
+	}
+
+	return b.sendOrders(c, ordersQuery{kind: ordersQuerySearch, arg: text}, false)
+}
+
+func (b *TelegramBot) onOrdersPage(c telebot.Context) error {
+	query, err := parseOrdersQuery(c.Data())
+	if err != nil {
+		b.logger.Warn("invalid telegram callback", zap.Error(err))
+
+		return c.Respond(&telebot.CallbackResponse{Text: "This list is outdated."})
+	}
+
+	if err = b.sendOrders(c, query, true); err != nil {
+		return err
+	}
+
+	return c.Respond()
+}
+
+// sendOrders replies with a page of orders, edit replaces the message the page buttons belong to.
+func (b *TelegramBot) sendOrders(c telebot.Context, query ordersQuery, edit bool) error {
+	filter, title, err := b.ordersFilter(query)
+	if err != nil {
+		return c.Send(err.Error())
+	}
+
+	ctx, cancel := b.commandContext(c)
+	defer cancel()
+
+	found, err := b.orders.Orders(ctx, filter)
+	if err != nil && !errors.Is(err, orders.ErrNotFound) {
+		b.logger.Error("failed to get orders for telegram", zap.Error(err), zap.String("query", title))
+
+		return c.Send("Failed to load orders, try again later.")
+	}
+
+	if len(found) == 0 {
+		text := "No orders found: " + title + "."
+		if edit {
+			return c.Edit(text)
+		}
+
+		return c.Send(text)
+	}
+
+	pages := (len(found) + ordersPageSize - 1) / ordersPageSize
+	page := min(query.page, pages-1)
+
+	from := page * ordersPageSize
+	to := min(from+ordersPageSize, len(found))
+
+	messages := make([]string, 0, to-from)
+	for _, order := range found[from:to] {
+		listed := *order
+		if listed.AdditionalInfo != nil {
+			info := truncate(*listed.AdditionalInfo, maxListedInfoLength)
+			listed.AdditionalInfo = &info
+		}
+
+		messages = append(messages, formatOrderMessage(orderHeading(&listed), &listed))
+	}
+
+	text := fmt.Sprintf("<b>Orders: %s</b>, %d total, page %d/%d\n\n%s",
+		html.EscapeString(title), len(found), page+1, pages, strings.Join(messages, "\n\n"))
+
+	opts := &telebot.SendOptions{ParseMode: telebot.ModeHTML, ReplyMarkup: ordersPageMarkup(query, page, pages)}
+
+	if edit {
+		return c.Edit(text, opts)
+	}
+
+	return c.Send(text, opts)
+}
+
+func (b *TelegramBot) ordersFilter(query ordersQuery) (*orders.Filter, string, error) {
+	switch query.kind {
+	case ordersQueryStatus:
+		if query.arg == "" {
+			return nil, "all", nil
+		}
+
+		status, ok := orderStatusByName(query.arg)
+		if !ok {
+			return nil, "", fmt.Errorf("unknown status %q", query.arg)
+		}
+
+		return &orders.Filter{OrderStatus: &status}, query.arg, nil
+	case ordersQueryToday:
+		today := moveDate(time.Now().In(b.location))
+
+		return &orders.Filter{MoveDateFrom: &today, MoveDateTo: &today}, "moving today", nil
+	case ordersQuerySearch:
+		search := query.arg
+
+		return &orders.Filter{Search: &search}, "search " + query.arg, nil
+	default:
+		return nil, "", fmt.Errorf("unknown orders list %q", query.kind)
+	}
+}
+
+func ordersPageMarkup(query ordersQuery, page, pages int) *telebot.ReplyMarkup {
+	markup := &telebot.ReplyMarkup{}
+	if pages < 2 {
+		return markup
+	}
+
+	var buttons []telebot.Btn
+	if page > 0 {
+		buttons = append(buttons, markup.Data("« Prev", ordersPageUnique, query.data(page-1)...))
+	}
+
+	if page < pages-1 {
+		buttons = append(buttons, markup.Data("Next »", ordersPageUnique, query.data(page+1)...))
+	}
+
+	markup.Inline(markup.Row(buttons...))
+
+	return markup
+}
+
+func orderHeading(order *orders.Order) string {
+	return "ORDER [" + orderStatusNames[order.OrderStatus] + "]"
+}
+
+func orderStatusByName(name string) (orders.OrderStatus, bool) {
+	for status, statusName := range orderStatusNames {
+		if statusName == name {
+			return status, true
+		}
+	}
+
+	return orders.OrderStatusUnknown, false
+}
+
+func truncate(s string, limit int) string {
+	if utf8.RuneCountInString(s) <= limit {
+		return s
+	}
+
+	return string([]rune(s)[:limit]) + "…"
+}
@@ -0,0 +1,345 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"gopkg.in/telebot.v4"
+
+	"github.com/ingvarmattis/moving/src/infra/tenant"
+	"github.com/ingvarmattis/moving/src/transport/orders"
+	"github.com/ingvarmattis/moving/src/transport/telegram"
+)
+
+const (
+	weekDays = 7
+
+	// messageLimit is the longest text telegram accepts in one message.
+	messageLimit = 4096
+)
+
+// DigestSchedule clock times are offsets from the local midnight of the bot location.
+type DigestSchedule struct {
+	Daily   bool
+	DailyAt time.Duration
+
+	Weekly    bool
+	WeeklyDay time.Weekday
+	WeeklyAt  time.Duration
+}
+
+var arrivalWindowNames = []struct {
+	window orders.ArrivalWindow
+	name   string
+}{
+	{window: orders.ArrivalWindowMorning, name: "Morning"},
+	{window: orders.ArrivalWindowMidday, name: "Midday"},
+	{window: orders.ArrivalWindowAfternoon, name: "Afternoon"},
+	{window: orders.ArrivalWindowUnknown, name: "Window not set"},
+}
+
+func arrivalWindowName(window orders.ArrivalWindow) (string, bool) {
+	if window == orders.ArrivalWindowUnknown {
+		return "", false
+	}
+
+	for _, named := range arrivalWindowNames {
+		if named.window == window {
+			return strings.ToLower(named.name), true
+		}
+	}
+
+	return "", false
+}
+
+// RunDigests posts the scheduled digests of every tenant until ctx is done,
+// each digest of a tenant is posted by one replica only.
+func (b *TelegramBot) RunDigests(ctx context.Context) {
+	var wg sync.WaitGroup
+
+	if b.digests.Daily {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			b.runSchedule(ctx, "daily", b.nextDailyDigest, b.sendDailyDigest)
+		}()
+	}
+
+	if b.digests.Weekly {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			b.runSchedule(ctx, "weekly", b.nextWeeklyDigest, b.sendWeeklyDigest)
+		}()
+	}
+
+	wg.Wait()
+}
+
+func (b *TelegramBot) runSchedule(
+	ctx context.Context, name string,
+	next func(now time.Time) time.Time, send func(ctx context.Context, at time.Time) error,
+) {
+	for {
+		now := time.Now().In(b.location)
+		at := next(now)
+
+		b.logger.Info("telegram digest scheduled", zap.String("digest", name), zap.Time("at", at))
+
+		timer := time.NewTimer(at.Sub(now))
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return
+		case <-timer.C:
+		}
+
+		tenants, err := b.tenants.Tenants(ctx)
+		if err != nil {
+			b.logger.Error("failed to get tenants for telegram digest", zap.Error(err), zap.String("digest", name))
+
+			continue
+		}
+
+		for _, t := range tenants {
+			b.runDigest(ctx, t, name, at, send)
+		}
+	}
+}
+
+// runDigest posts the digest to the chats of the tenant unless another replica claimed it.
+func (b *TelegramBot) runDigest(
+	ctx context.Context, t *tenant.Tenant, name string, at time.Time,
+	send func(ctx context.Context, at time.Time) error,
+) {
+	ctx = tenant.WithTenant(ctx, t)
+	logger := b.logger.With(zap.String("digest", name), zap.String("tenant", t.Slug))
+
+	claimed, err := b.subscriptions.ClaimDigest(ctx, name, at)
+	if err != nil {
+		logger.Error("failed to claim telegram digest", zap.Error(err))
+
+		return
+	}
+
+	if !claimed {
+		logger.Info("telegram digest is sent by another replica")
+
+		return
+	}
+
+	if err = send(ctx, at); err != nil {
+		logger.Error("failed to send telegram digest", zap.Error(err))
+	}
+}
+
+func (b *TelegramBot) nextDailyDigest(now time.Time) time.Time {
+	at := atClock(now, b.digests.DailyAt)
+	if !at.After(now) {
+		at = atClock(now.AddDate(0, 0, 1), b.digests.DailyAt)
+	}
+
+	return at
+}
+
+func (b *TelegramBot) nextWeeklyDigest(now time.Time) time.Time {
+	days := (int(b.digests.WeeklyDay) - int(now.Weekday()) + weekDays) % weekDays
+
+	at := atClock(now.AddDate(0, 0, days), b.digests.WeeklyAt)
+	if !at.After(now) {
+		at = atClock(now.AddDate(0, 0, days+weekDays), b.digests.WeeklyAt)
+	}
+
+	return at
+}
+
+// sendDailyDigest posts the moves of the next day grouped by arrival window.
+func (b *TelegramBot) sendDailyDigest(ctx context.Context, at time.Time) error {
+	tomorrow := moveDate(at.AddDate(0, 0, 1))
+
+	found, err := b.upcomingOrders(ctx, tomorrow, tomorrow)
+	if err != nil {
+		return err
+	}
+
+	var text strings.Builder
+	fmt.Fprintf(&text, "<b>Moves for %s: %d</b>", tomorrow.Format("Mon, 02 Jan"), len(found))
+
+	if len(found) == 0 {
+		text.WriteString("\n\nNo moves scheduled.")
+	}
+
+	for _, window := range arrivalWindowNames {
+		var listed []string
+
+		for _, order := range found {
+			if order.ArrivalWindow == window.window {
+				listed = append(listed, formatDigestOrder(order))
+			}
+		}
+
+		if len(listed) == 0 {
+			continue
+		}
+
+		text.WriteString("\n\n<b>")
+		text.WriteString(window.name)
+		text.WriteString("</b>\n")
+		text.WriteString(strings.Join(listed, "\n\n"))
+	}
+
+	return b.sendDigest(ctx, text.String())
+}
+
+// sendWeeklyDigest posts the number of moves for each day of the week ahead.
+func (b *TelegramBot) sendWeeklyDigest(ctx context.Context, at time.Time) error {
+	from := moveDate(at.AddDate(0, 0, 1))
+	to := from.AddDate(0, 0, weekDays-1)
+
+	found, err := b.upcomingOrders(ctx, from, to)
+	if err != nil {
+		return err
+	}
+
+	byDay := make(map[time.Time][]*orders.Order, weekDays)
+	for _, order := range found {
+		day := moveDate(order.MoveDate)
+		byDay[day] = append(byDay[day], order)
+	}
+
+	var text strings.Builder
+	fmt.Fprintf(&text, "<b>Week ahead %s - %s: %d moves</b>\n",
+		from.Format("02 Jan"), to.Format("02 Jan"), len(found))
+
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		text.WriteString("\n")
+		text.WriteString(day.Format("Mon, 02 Jan"))
+		text.WriteString(": ")
+		text.WriteString(formatDaySummary(byDay[day]))
+	}
+
+	return b.sendDigest(ctx, text.String())
+}
+
+// upcomingOrders returns orders moving between the dates inclusive, rejected ones are skipped.
+func (b *TelegramBot) upcomingOrders(ctx context.Context, from, to time.Time) ([]*orders.Order, error) {
+	found, err := b.orders.Orders(ctx, &orders.Filter{MoveDateFrom: &from, MoveDateTo: &to})
+	if err != nil && !errors.Is(err, orders.ErrNotFound) {
+		return nil, fmt.Errorf("failed to get upcoming orders | %w", err)
+	}
+
+	upcoming := make([]*orders.Order, 0, len(found))
+	for _, order := range found {
+		if order.OrderStatus != orders.OrderStatusRejected {
+			upcoming = append(upcoming, order)
+		}
+	}
+
+	return upcoming, nil
+}
+
+func (b *TelegramBot) sendDigest(ctx context.Context, text string) error {
+	chatIDs, err := b.subscriptions.ChatIDs(ctx, telegram.EventDigests)
+	if err != nil {
+		return fmt.Errorf("failed to get digest chats | %w", err)
+	}
+
+	opts := &telebot.SendOptions{ParseMode: telebot.ModeHTML}
+
+	var errs []error
+	for _, chatID := range chatIDs {
+		for _, part := range splitMessage(text, messageLimit) {
+			if _, err := b.tb.Send(&telebot.Chat{ID: chatID}, part, opts); err != nil {
+				errs = append(errs, fmt.Errorf("chat %d | %w", chatID, err))
+
+				break
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// splitMessage cuts a long text between paragraphs, so html tags are never split.
+func splitMessage(text string, limit int) []string {
+	var (
+		parts   []string
+		current strings.Builder
+	)
+
+	for _, paragraph := range strings.Split(text, "\n\n") {
+		if current.Len() > 0 && current.Len()+len("\n\n")+len(paragraph) > limit {
+			parts = append(parts, current.String())
+			current.Reset()
+		}
+
+		if current.Len() > 0 {
+			current.WriteString("\n\n")
+		}
+
+		current.WriteString(paragraph)
+	}
+
+	return append(parts, current.String())
+}
+
+func formatDigestOrder(o *orders.Order) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "#%d %s [%s]\n", o.ID, html.EscapeString(o.Name), orderStatusNames[o.OrderStatus])
+	b.WriteString(html.EscapeString(o.MoveFrom))
+	b.WriteString(" → ")
+	b.WriteString(html.EscapeString(o.MoveTo))
+
+	if o.Phone != "" {
+		b.WriteString("\n")
+		b.WriteString(html.EscapeString(o.Phone))
+	}
+
+	return b.String()
+}
+
+func formatDaySummary(dayOrders []*orders.Order) string {
+	if len(dayOrders) == 0 {
+		return "no moves"
+	}
+
+	var windows []string
+	for _, window := range arrivalWindowNames {
+		count := 0
+
+		for _, order := range dayOrders {
+			if order.ArrivalWindow == window.window {
+				count++
+			}
+		}
+
+		if count > 0 {
+			windows = append(windows, fmt.Sprintf("%s %d", strings.ToLower(window.name), count))
+		}
+	}
+
+	return fmt.Sprintf("%d moves (%s)", len(dayOrders), strings.Join(windows, ", "))
+}
+
+// atClock returns the clock time of the day, it stays the same across daylight saving changes.
+func atClock(day time.Time, clock time.Duration) time.Time {
+	hours := int(clock / time.Hour)
+	minutes := int(clock % time.Hour / time.Minute)
+
+	return time.Date(day.Year(), day.Month(), day.Day(), hours, minutes, 0, 0, day.Location())
+}
+
+// moveDate is the date column value of the day, move dates are compared at midnight UTC.
+func moveDate(day time.Time) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, time.UTC)
+}
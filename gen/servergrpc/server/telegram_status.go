@@ -0,0 +1,140 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"gopkg.in/telebot.v4"
+
+	"github.com/ingvarmattis/moving/src/transport/orders"
+)
+
+const orderStatusUnique = "order_status"
+
+// orderStatusButton changes the order status, buttons are offered depending on the current one.
+type orderStatusButton struct {
+	text   string
+	status orders.OrderStatus
+}
+
+var (
+	acceptButton     = orderStatusButton{text: "Accept", status: orders.OrderStatusInProgress}
+	rejectButton     = orderStatusButton{text: "Reject", status: orders.OrderStatusRejected}
+	inProgressButton = orderStatusButton{text: "In progress", status: orders.OrderStatusInProgress}
+	doneButton       = orderStatusButton{text: "Done", status: orders.OrderStatusDone}
+)
+
+// orderStatusButtons has no accepted status to move to, so accepting a new order puts it in progress.
+var orderStatusButtons = map[orders.OrderStatus][]orderStatusButton{
+	orders.OrderStatusCreated:    {acceptButton, rejectButton, inProgressButton},
+	orders.OrderStatusInProgress: {doneButton, rejectButton},
+	orders.OrderStatusRejected:   {inProgressButton},
+	orders.OrderStatusDone:       {inProgressButton},
+}
+
+func (b *TelegramBot) handleOrderStatusButtons() {
+	b.tb.Handle(&telebot.Btn{Unique: orderStatusUnique}, b.onOrderStatus)
+}
+
+func orderStatusMarkup(order *orders.Order) *telebot.ReplyMarkup {
+	markup := &telebot.ReplyMarkup{}
+
+	buttons := make([]telebot.Btn, 0, len(orderStatusButtons[order.OrderStatus]))
+	for _, button := range orderStatusButtons[order.OrderStatus] {
+		buttons = append(buttons, markup.Data(
+			button.text, orderStatusUnique, strconv.FormatUint(order.ID, 10), orderStatusNames[button.status],
+		))
+	}
+
+	if len(buttons) > 0 {
+		markup.Inline(markup.Row(buttons...))
+	}
+
+	return markup
+}
+
+func (b *TelegramBot) onOrderStatus(c telebot.Context) error {
+	id, status, err := parseOrderStatusData(c.Data())
+	if err != nil {
+		b.logger.Warn("invalid telegram callback", zap.Error(err))
+
+		return c.Respond(&telebot.CallbackResponse{Text: "This button is outdated."})
+	}
+
+	ctx, cancel := b.commandContext(c)
+	defer cancel()
+
+	if err = b.orders.UpdateOrder(ctx, &orders.UpdateOrderRequest{ID: id, OrderStatus: &status}); err != nil {
+		if errors.Is(err, orders.ErrNotFound) {
+			return c.Respond(&telebot.CallbackResponse{Text: fmt.Sprintf("Order #%d not found.", id)})
+		}
+
+		b.logger.Error("failed to update order status from telegram", zap.Error(err), zap.Uint64("order_id", id))
+
+		return c.Respond(&telebot.CallbackResponse{Text: "Failed to change the status, try again later."})
+	}
+
+	order, err := b.orders.OrderByID(ctx, id)
+	if err != nil {
+		b.logger.Error("failed to get order for telegram", zap.Error(err), zap.Uint64("order_id", id))
+
+		return c.Respond(&telebot.CallbackResponse{Text: "Status changed."})
+	}
+
+	b.logger.Info("order status changed from telegram",
+		zap.Uint64("order_id", id),
+		zap.String("status", orderStatusNames[status]),
+		zap.Int64("user_id", c.Sender().ID),
+	)
+
+	text := formatOrderMessage(orderHeading(order), order) + fmt.Sprintf(
+		"\n\n<i>Status changed to %s by %s at %s</i>",
+		orderStatusNames[status], html.EscapeString(senderName(c.Sender())),
+		time.Now().In(b.location).Format("2006-01-02 15:04"),
+	)
+
+	if err = c.Edit(text, &telebot.SendOptions{ParseMode: telebot.ModeHTML, ReplyMarkup: orderStatusMarkup(order)}); err != nil {
+		b.logger.Error("failed to edit telegram order message", zap.Error(err), zap.Uint64("order_id", id))
+	}
+
+	return c.Respond(&telebot.CallbackResponse{Text: "Status changed to " + orderStatusNames[status] + "."})
+}
+
+func parseOrderStatusData(data string) (uint64, orders.OrderStatus, error) {
+	idPart, statusPart, ok := strings.Cut(data, "|")
+	if !ok {
+		return 0, orders.OrderStatusUnknown, fmt.Errorf("invalid order status data %q", data)
+	}
+
+	id, err := strconv.ParseUint(idPart, 10, 64)
+	if err != nil {
+		return 0, orders.OrderStatusUnknown, fmt.Errorf("invalid order id %q | %w", idPart, err)
+	}
+
+	status, ok := orderStatusByName(statusPart)
+	if !ok {
+		return 0, orders.OrderStatusUnknown, fmt.Errorf("invalid order status %q", statusPart)
+	}
+
+	return id, status, nil
+}
+
+func senderName(user *telebot.User) string {
+	name := strings.TrimSpace(user.FirstName + " " + user.LastName)
+
+	switch {
+	case user.Username != "" && name != "":
+		return name + " (@" + user.Username + ")"
+	case user.Username != "":
+		return "@" + user.Username
+	case name != "":
+		return name
+	default:
+		return strconv.FormatInt(user.ID, 10)
+	}
+}
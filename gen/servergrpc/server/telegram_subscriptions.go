@@ -0,0 +1,261 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"go.uber.org/zap"
+	"gopkg.in/telebot.v4"
+
+	"github.com/ingvarmattis/moving/src/infra/tenant"
+	"github.com/ingvarmattis/moving/src/transport/telegram"
+)
+
+const (
+	settingsUnique = "settings"
+
+	// chatTenantKey keeps the tenant of the chat in the telebot context.
+	chatTenantKey = "tenant"
+)
+
+const settingsText = "Notifications for this chat, press to switch:"
+
+// subscriptionEvents are listed in /settings in this order.
+var subscriptionEvents = []struct {
+	event string
+	name  string
+}{
+	{event: telegram.EventNewOrders, name: "New orders"},
+	{event: telegram.EventStatusChanges, name: "Status changes"},
+	{event: telegram.EventDigests, name: "Digests"},
+}
+
+func (b *TelegramBot) handleSubscriptionCommands() {
+	b.tb.Handle("/start", b.onStart)
+	b.tb.Handle("/stop", b.onStop)
+	b.tb.Handle("/settings", b.onSettings)
+	b.tb.Handle(&telebot.Btn{Unique: settingsUnique}, b.onSettingsToggle)
+}
+
+// authMiddleware drops updates of chats without an active subscription, /start is let through to redeem invites.
+// Commands of the chat work with the tenant it is subscribed to, see commandContext.
+func (b *TelegramBot) authMiddleware() telebot.MiddlewareFunc {
+	return func(next telebot.HandlerFunc) telebot.HandlerFunc {
+		return func(c telebot.Context) error {
+			if c.Chat() == nil {
+				return nil
+			}
+
+			if isCommand(c, "/start") {
+				return next(c)
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), commandTimeout)
+			defer cancel()
+
+			subscription, err := b.subscriptions.Subscription(ctx, c.Chat().ID)
+			if err != nil {
+				if !errors.Is(err, telegram.ErrNotFound) {
+					b.logger.Error("failed to authorize telegram chat", zap.Error(err), zap.Int64("chat_id", c.Chat().ID))
+				}
+
+				return nil
+			}
+
+			chatTenant, err := b.tenants.TenantByID(ctx, subscription.TenantID)
+			if err != nil {
+				b.logger.Error("failed to get tenant of telegram chat", zap.Error(err), zap.Int64("chat_id", c.Chat().ID))
+
+				return nil
+			}
+
+			c.Set(chatTenantKey, chatTenant)
+
+			return next(c)
+		}
+	}
+}
+
+// onStart subscribes the chat with an invite code, a subscribed chat gets its notifications back after /stop.
+func (b *TelegramBot) onStart(c telebot.Context) error {
+	ctx, cancel := context.WithTimeout(context.Background(), commandTimeout)
+	defer cancel()
+
+	chatID := c.Chat().ID
+
+	subscription, err := b.subscriptions.Subscription(ctx, chatID)
+	switch {
+	case err == nil:
+		preferences := subscription.Preferences
+		if !preferences.NewOrders && !preferences.StatusChanges && !preferences.Digests {
+			chatTenant, tenantErr := b.tenants.TenantByID(ctx, subscription.TenantID)
+			if tenantErr != nil {
+				b.logger.Error("failed to get tenant of telegram chat", zap.Error(tenantErr), zap.Int64("chat_id", chatID))
+
+				return c.Send("Failed to resume notifications, try again later.")
+			}
+
+			if err = b.subscriptions.UpdatePreferences(tenant.WithTenant(ctx, chatTenant), chatID, &telegram.Preferences{
+				NewOrders: true, StatusChanges: true, Digests: true,
+			}); err != nil {
+				b.logger.Error("failed to resume telegram notifications", zap.Error(err), zap.Int64("chat_id", chatID))
+
+				return c.Send("Failed to resume notifications, try again later.")
+			}
+		}
+
+		return c.Send("This chat is subscribed to updates. Use /settings to choose notifications and /help for commands.")
+	case !errors.Is(err, telegram.ErrNotFound):
+		b.logger.Error("failed to get telegram subscription", zap.Error(err), zap.Int64("chat_id", chatID))
+
+		return c.Send("Failed to subscribe, try again later.")
+	}
+
+	code := strings.TrimSpace(c.Message().Payload)
+	if code == "" {
+		return c.Send("Ask an administrator for an invite code and send /start <code>.")
+	}
+
+	if _, err = b.subscriptions.Subscribe(ctx, code, chatID, chatTitle(c.Chat())); err != nil {
+		if errors.Is(err, telegram.ErrInvalidInvite) {
+			return c.Send("The invite code is invalid, used or expired.")
+		}
+
+		b.logger.Error("failed to subscribe telegram chat", zap.Error(err), zap.Int64("chat_id", chatID))
+
+		return c.Send("Failed to subscribe, try again later.")
+	}
+
+	b.logger.Info("telegram chat subscribed", zap.Int64("chat_id", chatID))
+
+	return c.Send("You have subscribed to updates. Use /settings to choose notifications and /help for commands.")
+}
+
+// onStop switches all notifications off, the chat may still use commands and /start turns them on.
+func (b *TelegramBot) onStop(c telebot.Context) error {
+	ctx, cancel := b.commandContext(c)
+	defer cancel()
+
+	if err := b.subscriptions.UpdatePreferences(ctx, c.Chat().ID, &telegram.Preferences{}); err != nil {
+		b.logger.Error("failed to stop telegram notifications", zap.Error(err), zap.Int64("chat_id", c.Chat().ID))
+
+		return c.Send("Failed to stop notifications, try again later.")
+	}
+
+	return c.Send("Notifications are stopped. Send /start to get them again.")
+}
+
+func (b *TelegramBot) onSettings(c telebot.Context) error {
+	ctx, cancel := b.commandContext(c)
+	defer cancel()
+
+	subscription, err := b.subscriptions.Subscription(ctx, c.Chat().ID)
+	if err != nil {
+		b.logger.Error("failed to get telegram subscription", zap.Error(err), zap.Int64("chat_id", c.Chat().ID))
+
+		return c.Send("Failed to load settings, try again later.")
+	}
+
+	return c.Send(settingsText, settingsMarkup(subscription.Preferences))
+}
+
+func (b *TelegramBot) onSettingsToggle(c telebot.Context) error {
+	ctx, cancel := b.commandContext(c)
+	defer cancel()
+
+	subscription, err := b.subscriptions.Subscription(ctx, c.Chat().ID)
+	if err != nil {
+		b.logger.Error("failed to get telegram subscription", zap.Error(err), zap.Int64("chat_id", c.Chat().ID))
+
+		return c.Respond(&telebot.CallbackResponse{Text: "Failed to load settings, try again later."})
+	}
+
+	preferences := subscription.Preferences
+
+	switch c.Data() {
+	case telegram.EventNewOrders:
+		preferences.NewOrders = !preferences.NewOrders
+	case telegram.EventStatusChanges:
+		preferences.StatusChanges = !preferences.StatusChanges
+	case telegram.EventDigests:
+		preferences.Digests = !preferences.Digests
+	default:
+		return c.Respond(&telebot.CallbackResponse{Text: "This button is outdated."})
+	}
+
+	if err = b.subscriptions.UpdatePreferences(ctx, c.Chat().ID, &preferences); err != nil {
+		b.logger.Error("failed to update telegram preferences", zap.Error(err), zap.Int64("chat_id", c.Chat().ID))
+
+		return c.Respond(&telebot.CallbackResponse{Text: "Failed to save settings, try again later."})
+	}
+
+	if err = c.Edit(settingsText, settingsMarkup(preferences)); err != nil {
+		b.logger.Error("failed to edit telegram settings message", zap.Error(err), zap.Int64("chat_id", c.Chat().ID))
+	}
+
+	return c.Respond(&telebot.CallbackResponse{Text: "Saved."})
+}
+
+func settingsMarkup(preferences telegram.Preferences) *telebot.ReplyMarkup {
+	enabled := map[string]bool{
+		telegram.EventNewOrders:     preferences.NewOrders,
+		telegram.EventStatusChanges: preferences.StatusChanges,
+		telegram.EventDigests:       preferences.Digests,
+	}
+
+	markup := &telebot.ReplyMarkup{}
+
+	rows := make([]telebot.Row, 0, len(subscriptionEvents))
+	for _, event := range subscriptionEvents {
+		mark := "❌ "
+		if enabled[event.event] {
+			mark = "✅ "
+		}
+
+		rows = append(rows, markup.Row(markup.Data(mark+event.name, settingsUnique, event.event)))
+	}
+
+	markup.Inline(rows...)
+
+	return markup
+}
+
+// commandContext is the context of a command of a chat let through by authMiddleware, it carries the chat tenant.
+func (b *TelegramBot) commandContext(c telebot.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithTimeout(context.Background(), commandTimeout)
+
+	if chatTenant, ok := c.Get(chatTenantKey).(*tenant.Tenant); ok {
+		ctx = tenant.WithTenant(ctx, chatTenant)
+	}
+
+	return ctx, cancel
+}
+
+// isCommand tells if the message is the command, also when it is addressed as /command@bot in groups.
+func isCommand(c telebot.Context, command string) bool {
+	if c.Callback() != nil || c.Message() == nil {
+		return false
+	}
+
+	fields := strings.Fields(c.Message().Text)
+	if len(fields) == 0 {
+		return false
+	}
+
+	name, _, _ := strings.Cut(fields[0], "@")
+
+	return name == command
+}
+
+func chatTitle(chat *telebot.Chat) string {
+	if chat.Title != "" {
+		return chat.Title
+	}
+
+	if name := strings.TrimSpace(chat.FirstName + " " + chat.LastName); name != "" {
+		return name
+	}
+
+	return chat.Username
+}
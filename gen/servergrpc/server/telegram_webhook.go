@@ -0,0 +1,63 @@
+package server
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+
+	"go.uber.org/zap"
+	"gopkg.in/telebot.v4"
+)
+
+const (
+	// telegramSecretHeader carries the secret token given to setWebhook.
+	telegramSecretHeader = "X-Telegram-Bot-Api-Secret-Token"
+
+	maxUpdateSize = 1 << 20
+)
+
+// TelegramWebhook PublicURL is where telegram posts updates, it must reach UpdatesHandler.
+type TelegramWebhook struct {
+	PublicURL string
+	Secret    string
+}
+
+// webhookPoller registers the webhook and waits, updates come through UpdatesHandler.
+// Every replica registers the same webhook, so any of them may serve an update.
+type webhookPoller struct {
+	webhook *telebot.Webhook
+	logger  *zap.Logger
+}
+
+func (p *webhookPoller) Poll(b *telebot.Bot, _ chan telebot.Update, stop chan struct{}) {
+	if err := b.SetWebhook(p.webhook); err != nil {
+		p.logger.Error("failed to set telegram webhook", zap.Error(err))
+	}
+
+	<-stop
+}
+
+// UpdatesHandler serves updates telegram posts to the webhook, requests without the secret token are rejected.
+func (b *TelegramBot) UpdatesHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if b.webhookSecret == "" || subtle.ConstantTimeCompare(
+			[]byte(r.Header.Get(telegramSecretHeader)), []byte(b.webhookSecret),
+		) != 1 {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		var update telebot.Update
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxUpdateSize)).Decode(&update); err != nil {
+			b.logger.Error("failed to decode telegram update", zap.Error(err))
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		b.tb.ProcessUpdate(update)
+
+		w.WriteHeader(http.StatusOK)
+	})
+}
@@ -29,6 +29,8 @@ type Env struct {
 	PGXPool *pgxpool.Pool
 
 	Logger *zap.Logger
+	// LogLevel of Logger, it is changed on config reload.
+	LogLevel zap.AtomicLevel
 
 	TraceProvider *tracesdk.TracerProvider
 	Tracer        trace.Tracer
@@ -45,7 +47,12 @@ func NewENV(ctx context.Context) (*Env, error) {
 		return nil, fmt.Errorf("error creating postgres connection | %w", err)
 	}
 
-	logger := provideLogger()
+	logLevel, err := zap.ParseAtomicLevel(cfg.LogLevel)
+	if err != nil {
+		return nil, fmt.Errorf("invalid log level | %w", err)
+	}
+
+	logger := provideLogger(logLevel)
 
 	tracer, traceProvider, err := provideTracer(ctx, cfg.TracingConfig.Enabled, cfg.ServiceName, cfg.TracingConfig.URL, cfg.TracingConfig.UseTLS)
 	if err != nil {
@@ -56,15 +63,16 @@ func NewENV(ctx context.Context) (*Env, error) {
 		Config:        cfg,
 		PGXPool:       pgPool,
 		Logger:        logger,
+		LogLevel:      logLevel,
 		Tracer:        tracer,
 		TraceProvider: traceProvider,
 	}, nil
 }
 
 func provideConfig() (*config.Config, error) {
-	cfg, err := config.FromEnv()
+	cfg, err := config.Load()
 	if err != nil {
-		return nil, fmt.Errorf("cannot parse config from environment | %w", err)
+		return nil, fmt.Errorf("cannot load config | %w", err)
 	}
 
 	return cfg, nil
@@ -91,7 +99,7 @@ func providePGXPool(ctx context.Context, connConfig string) (*pgxpool.Pool, erro
 	return pool, nil
 }
 
-func provideLogger() *zap.Logger {
+func provideLogger(level zap.AtomicLevel) *zap.Logger {
 	encoderCfg := zapcore.EncoderConfig{
 		MessageKey:  "m",
 		NameKey:     "logger",
@@ -101,7 +109,7 @@ func provideLogger() *zap.Logger {
 		EncodeTime:  zapcore.ISO8601TimeEncoder,
 	}
 
-	return zap.New(zapcore.NewCore(zapcore.NewJSONEncoder(encoderCfg), os.Stdout, zap.DebugLevel))
+	return zap.New(zapcore.NewCore(zapcore.NewJSONEncoder(encoderCfg), os.Stdout, level))
 }
 
 func provideTracer(
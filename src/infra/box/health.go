@@ -0,0 +1,64 @@
+package box
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/ingvarmattis/moving/gen/servergrpc/server"
+	"github.com/ingvarmattis/moving/src/infra/health"
+	outboxsvc "github.com/ingvarmattis/moving/src/services/outbox"
+)
+
+const (
+	healthCheckPostgres = "postgres"
+	healthCheckOutbox   = "outbox"
+	healthCheckTelegram = "telegram"
+
+	livenessPath  = "/healthz"
+	readinessPath = "/readyz"
+)
+
+// provideHealthChecker reports to the grpc health service of the whole server ("") and of the service name.
+func provideHealthChecker(
+	envBox *Env, grpcServer *server.Server, outboxDispatcher *outboxsvc.Dispatcher, telegramBot TelegramBotInterface,
+) (*health.Checker, error) {
+	cfg := envBox.Config.HealthConfig
+
+	checker := health.NewChecker(
+		grpcServer.HealthServer(), envBox.Logger.With(zap.String("type", "health")),
+		health.Options{Interval: cfg.Interval, Timeout: cfg.Timeout},
+		"", envBox.Config.ServiceName,
+	)
+
+	checker.Add(healthCheckPostgres, true, envBox.PGXPool.Ping)
+	checker.Add(healthCheckOutbox, false, func(ctx context.Context) error {
+		lag, err := outboxDispatcher.Lag(ctx)
+		if err != nil {
+			return err
+		}
+
+		if lag > cfg.OutboxMaxLag {
+			return fmt.Errorf("oldest due event waits for %s", lag.Round(time.Second))
+		}
+
+		return nil
+	})
+
+	if envBox.Config.TelegramConfig.Enabled {
+		checker.Add(healthCheckTelegram, false, telegramBot.Check)
+	}
+
+	if err := grpcServer.HandleHTTP(http.MethodGet, livenessPath, checker.LivenessHandler()); err != nil {
+		return nil, fmt.Errorf("failed to register liveness probe | %w", err)
+	}
+
+	if err := grpcServer.HandleHTTP(http.MethodGet, readinessPath, checker.ReadinessHandler()); err != nil {
+		return nil, fmt.Errorf("failed to register readiness probe | %w", err)
+	}
+
+	return checker, nil
+}
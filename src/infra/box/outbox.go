@@ -0,0 +1,349 @@
+package box
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	netmail "net/mail"
+
+	"go.uber.org/zap"
+
+	"github.com/ingvarmattis/moving/src/infra/mailer"
+	"github.com/ingvarmattis/moving/src/infra/metrics"
+	"github.com/ingvarmattis/moving/src/infra/tenant"
+	movingrepo "github.com/ingvarmattis/moving/src/repositories/orders"
+	outboxrepo "github.com/ingvarmattis/moving/src/repositories/outbox"
+	outboxsvc "github.com/ingvarmattis/moving/src/services/outbox"
+	smssvc "github.com/ingvarmattis/moving/src/services/sms"
+	tenantssvc "github.com/ingvarmattis/moving/src/services/tenants"
+	webhookssvc "github.com/ingvarmattis/moving/src/services/webhooks"
+	"github.com/ingvarmattis/moving/src/transport/orders"
+)
+
+const (
+	templateOrderConfirmation = "order_confirmation"
+	templateOfficeNewOrder    = "office_new_order"
+)
+
+// provideOutboxDestinations lists destinations every order event is written for.
+func provideOutboxDestinations(envBox *Env) []string {
+	var destinations []string
+
+	if envBox.Config.TelegramConfig.Enabled {
+		destinations = append(destinations, outboxsvc.DestinationTelegram)
+	}
+
+	if envBox.Config.SMSConfig.Enabled {
+		destinations = append(destinations, outboxsvc.DestinationCustomerSMS)
+	}
+
+	if envBox.Config.WebhooksConfig.Enabled {
+		destinations = append(destinations, outboxsvc.DestinationWebhooks)
+	}
+
+	if envBox.Config.MailerConfig.Enabled {
+		destinations = append(destinations, outboxsvc.DestinationCustomerEmail)
+
+		if len(envBox.Config.MailerConfig.OfficeInbox) > 0 {
+			destinations = append(destinations, outboxsvc.DestinationOfficeEmail)
+		}
+	}
+
+	return destinations
+}
+
+func provideOutboxDispatcher(
+	envBox *Env, businessMetrics *metrics.Business,
+	ordersHandlers *orders.Handlers, telegramBot TelegramBotInterface,
+	smsService *smssvc.Service, webhooksService *webhookssvc.Service, tenantsService *tenantssvc.Service,
+) (*outboxsvc.Dispatcher, error) {
+	cfg := envBox.Config.OutboxConfig
+
+	dispatcher := outboxsvc.NewDispatcher(
+		outboxrepo.NewPostgres(envBox.PGXPool), businessMetrics, envBox.Logger.With(zap.String("type", "outbox")),
+		outboxsvc.Options{
+			PollInterval: cfg.PollInterval,
+			BatchSize:    cfg.BatchSize,
+			Lease:        cfg.Lease,
+			MaxAttempts:  cfg.MaxAttempts,
+			BaseBackoff:  cfg.BaseBackoff,
+			MaxBackoff:   cfg.MaxBackoff,
+			Retention:    cfg.Retention,
+		},
+	)
+
+	dispatcher.Register(outboxsvc.DestinationTelegram, telegramOrderHandler(ordersHandlers, telegramBot, tenantsService))
+
+	if smsService != nil {
+		dispatcher.Register(
+			outboxsvc.DestinationCustomerSMS, customerSMSHandler(smsService, ordersHandlers, tenantsService),
+		)
+	}
+
+	if envBox.Config.WebhooksConfig.Enabled {
+		dispatcher.Register(outboxsvc.DestinationWebhooks, webhooksHandler(webhooksService))
+	}
+
+	if !envBox.Config.MailerConfig.Enabled {
+		return dispatcher, nil
+	}
+
+	mailerCfg := envBox.Config.MailerConfig
+
+	smtpMailer, err := mailer.NewSMTPMailer(mailer.SMTPOptions{
+		Host:     mailerCfg.SMTPHost,
+		Port:     mailerCfg.SMTPPort,
+		Username: mailerCfg.SMTPUsername,
+		Password: mailerCfg.SMTPPassword,
+		From:     mailerCfg.From,
+		StartTLS: mailerCfg.StartTLS,
+		Timeout:  mailerCfg.Timeout,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create mailer | %w", err)
+	}
+
+	templates, err := mailer.NewTemplates(mailerCfg.TemplatesDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load mail templates | %w", err)
+	}
+
+	dispatcher.Register(
+		outboxsvc.DestinationCustomerEmail,
+		customerEmailHandler(smtpMailer, templates, ordersHandlers, tenantsService, mailerCfg.ReplyTo),
+	)
+	dispatcher.Register(
+		outboxsvc.DestinationOfficeEmail,
+		officeEmailHandler(smtpMailer, templates, tenantsService, mailerCfg.OfficeInbox),
+	)
+
+	return dispatcher, nil
+}
+
+// telegramOrderHandler notifies about new orders and status changes, spam and other updates are skipped.
+func telegramOrderHandler(
+	ordersHandlers *orders.Handlers, bot TelegramBotInterface, tenants *tenantssvc.Service,
+) outboxsvc.Handler {
+	return func(ctx context.Context, event *outboxsvc.Event) error {
+		if event.Type != movingrepo.EventOrderCreated && event.Type != movingrepo.EventOrderStatusChanged {
+			return nil
+		}
+
+		var payload movingrepo.OrderEvent
+		if err := json.Unmarshal(event.Payload, &payload); err != nil {
+			return fmt.Errorf("failed to decode order event | %w", err)
+		}
+
+		if payload.SpamReason != nil {
+			return nil
+		}
+
+		orderTenant, err := tenants.TenantByID(ctx, payload.TenantID)
+		if err != nil {
+			return fmt.Errorf("failed to get tenant of order | %w", err)
+		}
+
+		ctx = tenant.WithTenant(ctx, orderTenant)
+
+		order, err := ordersHandlers.OrderByID(ctx, event.AggregateID)
+		if err != nil {
+			return fmt.Errorf("failed to get order | %w", err)
+		}
+
+		if event.Type == movingrepo.EventOrderStatusChanged {
+			return bot.NotifyStatusChange(ctx, order, payload.PreviousStatus)
+		}
+
+		return bot.NotifyNewOrder(ctx, order)
+	}
+}
+
+// customerEmailHandler sends the order confirmation to the customer, if the email is known and valid.
+// Replies go to the tenant email, the configured reply-to is used when the tenant has none.
+func customerEmailHandler(
+	m mailer.Mailer, templates *mailer.Templates,
+	ordersHandlers *orders.Handlers, tenants *tenantssvc.Service, replyTo string,
+) outboxsvc.Handler {
+	return func(ctx context.Context, event *outboxsvc.Event) error {
+		order, ok, err := newOrderFromEvent(event)
+		if err != nil || !ok {
+			return err
+		}
+
+		customer, ok := customerAddress(order)
+		if !ok {
+			return nil
+		}
+
+		orderTenant, err := tenants.TenantByID(ctx, order.TenantID)
+		if err != nil {
+			return fmt.Errorf("failed to get tenant of order | %w", err)
+		}
+
+		mail := newOrderMail(orderTenant, order)
+
+		if mail.ReviewURL, err = reviewURL(ctx, ordersHandlers, orderTenant, order.ID); err != nil {
+			return err
+		}
+
+		msg, err := templates.Render(templateOrderConfirmation, mail)
+		if err != nil {
+			return err
+		}
+
+		msg.To = []string{customer}
+		msg.FromName = mail.Brand.Name
+		msg.ReplyTo = replyTo
+
+		if mail.Brand.Email != "" {
+			msg.ReplyTo = mail.Brand.Email
+		}
+
+		return m.Send(ctx, msg)
+	}
+}
+
+// officeEmailHandler copies new orders to the office inbox, replies go to the customer.
+func officeEmailHandler(
+	m mailer.Mailer, templates *mailer.Templates, tenants *tenantssvc.Service, inbox []string,
+) outboxsvc.Handler {
+	return func(ctx context.Context, event *outboxsvc.Event) error {
+		order, ok, err := newOrderFromEvent(event)
+		if err != nil || !ok {
+			return err
+		}
+
+		orderTenant, err := tenants.TenantByID(ctx, order.TenantID)
+		if err != nil {
+			return fmt.Errorf("failed to get tenant of order | %w", err)
+		}
+
+		msg, err := templates.Render(templateOfficeNewOrder, newOrderMail(orderTenant, order))
+		if err != nil {
+			return err
+		}
+
+		msg.To = inbox
+
+		// an invalid customer email still gets the order to the office, just without reply-to
+		if customer, ok := customerAddress(order); ok {
+			msg.ReplyTo = customer
+		}
+
+		return m.Send(ctx, msg)
+	}
+}
+
+// customerAddress orders created before emails were validated may hold anything, retrying would not help.
+func customerAddress(order *movingrepo.OrderEvent) (string, bool) {
+	if order.Email == nil || *order.Email == "" {
+		return "", false
+	}
+
+	address, err := netmail.ParseAddress(*order.Email)
+	if err != nil {
+		return "", false
+	}
+
+	return address.Address, true
+}
+
+// customerSMSHandler texts the order confirmation, customers always leave a phone.
+func customerSMSHandler(
+	smsService *smssvc.Service, ordersHandlers *orders.Handlers, tenants *tenantssvc.Service,
+) outboxsvc.Handler {
+	return func(ctx context.Context, event *outboxsvc.Event) error {
+		order, ok, err := newOrderFromEvent(event)
+		if err != nil || !ok {
+			return err
+		}
+
+		orderTenant, err := tenants.TenantByID(ctx, order.TenantID)
+		if err != nil {
+			return fmt.Errorf("failed to get tenant of order | %w", err)
+		}
+
+		review, err := reviewURL(ctx, ordersHandlers, orderTenant, order.ID)
+		if err != nil {
+			return err
+		}
+
+		return smsService.SendOrderConfirmation(ctx, &smssvc.Order{
+			ID:        order.ID,
+			Name:      order.Name,
+			Phone:     order.Phone,
+			MoveDate:  order.MoveDate,
+			MoveFrom:  order.MoveFrom,
+			MoveTo:    order.MoveTo,
+			Brand:     orderTenant.Branding,
+			ReviewURL: review,
+		})
+	}
+}
+
+// webhooksHandler schedules deliveries of every order event except spam.
+func webhooksHandler(webhooksService *webhookssvc.Service) outboxsvc.Handler {
+	return func(ctx context.Context, event *outboxsvc.Event) error {
+		var order movingrepo.OrderEvent
+		if err := json.Unmarshal(event.Payload, &order); err != nil {
+			return fmt.Errorf("failed to decode order event | %w", err)
+		}
+
+		if order.SpamReason != nil {
+			return nil
+		}
+
+		return webhooksService.Fanout(ctx, order.TenantID, event.ID, event.Type, event.AggregateID, event.Payload)
+	}
+}
+
+// newOrderFromEvent decodes created orders which are not spam, ok is false for other events.
+func newOrderFromEvent(event *outboxsvc.Event) (*movingrepo.OrderEvent, bool, error) {
+	if event.Type != movingrepo.EventOrderCreated {
+		return nil, false, nil
+	}
+
+	var order movingrepo.OrderEvent
+	if err := json.Unmarshal(event.Payload, &order); err != nil {
+		return nil, false, fmt.Errorf("failed to decode order event | %w", err)
+	}
+
+	if order.SpamReason != nil {
+		return nil, false, nil
+	}
+
+	return &order, true, nil
+}
+
+// orderMail is what the order mail templates render, the order is branded as its tenant.
+type orderMail struct {
+	*movingrepo.OrderEvent
+	Brand tenant.Branding
+	// Estimate is the tenant price range for the property size, empty when the tenant has none.
+	Estimate string
+	// ReviewURL is set only for the customer, it carries the review secret of the order.
+	ReviewURL string
+}
+
+func newOrderMail(orderTenant *tenant.Tenant, order *movingrepo.OrderEvent) *orderMail {
+	estimate, _ := orderTenant.Pricing.Estimate(order.PropertySize)
+
+	return &orderMail{OrderEvent: order, Brand: orderTenant.Branding, Estimate: estimate}
+}
+
+// reviewURL links the customer to the review form of the order. The review secret is kept out of
+// the order events and api responses, the customer gets it only in this link.
+func reviewURL(
+	ctx context.Context, ordersHandlers *orders.Handlers, orderTenant *tenant.Tenant, orderID uint64,
+) (string, error) {
+	if orderTenant.Branding.SiteURL == "" {
+		return "", nil
+	}
+
+	order, err := ordersHandlers.OrderByID(tenant.WithTenant(ctx, orderTenant), orderID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get order | %w", err)
+	}
+
+	return orderTenant.Branding.ReviewURL(order.ID, order.ReviewSecret), nil
+}
@@ -0,0 +1,53 @@
+package box
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/ingvarmattis/moving/src/infra/config"
+	"github.com/ingvarmattis/moving/src/infra/tenant"
+)
+
+// Reload applies the reloadable settings of cfg without dropping connections,
+// other settings keep the values read on start.
+func (r *Resources) Reload(ctx context.Context, envBox *Env, cfg *config.Config) error {
+	logLevel, err := zapcore.ParseLevel(cfg.LogLevel)
+	if err != nil {
+		return fmt.Errorf("invalid log level | %w", err)
+	}
+
+	envBox.LogLevel.SetLevel(logLevel)
+	r.StaticTokens.Set(cfg.AuthConfig.ClientTokens, cfg.AuthConfig.AdminTokens)
+	r.RateLimits.Set(provideRateLimits(&cfg.RateLimitConfig))
+	r.GRPCServer.SetCORSAllowedOrigins(cfg.CORSAllowedOrigins)
+
+	if !envBox.Config.TelegramConfig.Enabled {
+		return nil
+	}
+
+	defaultTenant, err := r.TenantsService.Default(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get default tenant | %w", err)
+	}
+
+	revoked, err := r.TelegramService.Bootstrap(
+		tenant.WithTenant(ctx, defaultTenant), cfg.TelegramConfig.AllowedChatIDs,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to bootstrap telegram chats | %w", err)
+	}
+
+	logRevokedChats(envBox.Logger, revoked)
+
+	return nil
+}
+
+// logRevokedChats reports chats unsubscribed for missing from the allowed chat ids.
+func logRevokedChats(logger *zap.Logger, chatIDs []int64) {
+	if len(chatIDs) > 0 {
+		logger.Warn("telegram chats removed from the allowed chat ids are revoked", zap.Int64s("chat_ids", chatIDs))
+	}
+}
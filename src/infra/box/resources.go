@@ -2,78 +2,226 @@ package box
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
 
 	validatorv10 "github.com/go-playground/validator/v10"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 
 	"github.com/ingvarmattis/moving/gen/servergrpc/server"
+	"github.com/ingvarmattis/moving/src/infra/captcha"
+	"github.com/ingvarmattis/moving/src/infra/config"
+	"github.com/ingvarmattis/moving/src/infra/health"
+	"github.com/ingvarmattis/moving/src/infra/identity"
 	"github.com/ingvarmattis/moving/src/infra/interceptors"
+	"github.com/ingvarmattis/moving/src/infra/metrics"
+	"github.com/ingvarmattis/moving/src/infra/tenant"
+	apikeysrepo "github.com/ingvarmattis/moving/src/repositories/apikeys"
+	idempotencyrepo "github.com/ingvarmattis/moving/src/repositories/idempotency"
 	movingrepo "github.com/ingvarmattis/moving/src/repositories/orders"
+	ratelimitsrepo "github.com/ingvarmattis/moving/src/repositories/ratelimits"
 	reviewsrepo "github.com/ingvarmattis/moving/src/repositories/reviews"
+	telegramrepo "github.com/ingvarmattis/moving/src/repositories/telegram"
+	tenantsrepo "github.com/ingvarmattis/moving/src/repositories/tenants"
+	usersrepo "github.com/ingvarmattis/moving/src/repositories/users"
+	webhooksrepo "github.com/ingvarmattis/moving/src/repositories/webhooks"
+	apikeyssvc "github.com/ingvarmattis/moving/src/services/apikeys"
 	orderssvc "github.com/ingvarmattis/moving/src/services/orders"
+	outboxsvc "github.com/ingvarmattis/moving/src/services/outbox"
 	reviewssvc "github.com/ingvarmattis/moving/src/services/reviews"
+	smssvc "github.com/ingvarmattis/moving/src/services/sms"
+	telegramsvc "github.com/ingvarmattis/moving/src/services/telegram"
+	tenantssvc "github.com/ingvarmattis/moving/src/services/tenants"
+	userssvc "github.com/ingvarmattis/moving/src/services/users"
+	webhookssvc "github.com/ingvarmattis/moving/src/services/webhooks"
+	"github.com/ingvarmattis/moving/src/transport/apikeys"
 	"github.com/ingvarmattis/moving/src/transport/orders"
 	"github.com/ingvarmattis/moving/src/transport/reviews"
+	"github.com/ingvarmattis/moving/src/transport/telegram"
+	"github.com/ingvarmattis/moving/src/transport/users"
 	rpcvalidator "github.com/ingvarmattis/moving/src/transport/validator"
+	"github.com/ingvarmattis/moving/src/transport/webhooks"
 )
 
+// telegramSecretPattern is what telegram accepts as a webhook secret token.
+var telegramSecretPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{1,256}$`)
+
 // TelegramBotInterface is the contract for telegram bot (real or noop).
 type TelegramBotInterface interface {
-	NotifyNewOrder(order *orders.Order)
+	NotifyNewOrder(ctx context.Context, order *orders.Order) error
+	NotifyStatusChange(ctx context.Context, order *orders.Order, previousStatus string) error
+	RunDigests(ctx context.Context)
+	UpdatesHandler() http.Handler
 	Start()
 	Close()
+	Check(ctx context.Context) error
 }
 
 type Resources struct {
-	OrdersService  *orderssvc.Service
-	ReviewsService *reviewssvc.Service
+	OrdersStorage *movingrepo.Postgres
+
+	OrdersService   *orderssvc.Service
+	ReviewsService  *reviewssvc.Service
+	WebhooksService *webhookssvc.Service
+	TelegramService *telegramsvc.Service
+	UsersService    *userssvc.Service
+	APIKeysService  *apikeyssvc.Service
+	TenantsService  *tenantssvc.Service
 
 	Validator *validatorv10.Validate
 
+	// StaticTokens and RateLimits are the reloadable settings of the interceptors.
+	StaticTokens *interceptors.StaticTokens
+	RateLimits   *interceptors.LiveRateLimits
+
 	UnaryGRPCServerInterceptors  []grpc.UnaryServerInterceptor
 	StreamGRPCServerInterceptors []grpc.StreamServerInterceptor
 
 	GRPCServer    *server.Server
 	TelegramBot   TelegramBotInterface
 	MetricsServer *server.MetricsServer
+	HealthChecker *health.Checker
+
+	SMSService       *smssvc.Service
+	OutboxDispatcher *outboxsvc.Dispatcher
+	BusinessMetrics  *metrics.Business
 }
 
 func NewResources(ctx context.Context, envBox *Env) (*Resources, error) {
-	ordersService := orderssvc.NewService(movingrepo.NewPostgres(envBox.PGXPool))
-	reviewsService := reviewssvc.NewService(reviewsrepo.NewPostgres(envBox.PGXPool))
+	businessMetrics := metrics.NewBusiness(envBox.Config.MetricsConfig.Enabled, envBox.Config.ServiceName)
+
+	tenantsService := tenantssvc.NewService(
+		tenantsrepo.NewPostgres(envBox.PGXPool),
+		envBox.Config.TenantConfig.Default, envBox.Config.TenantConfig.CacheTTL,
+	)
+
+	ordersStorage := movingrepo.NewPostgres(envBox.PGXPool, provideOutboxDestinations(envBox)...)
+
+	ordersService := orderssvc.NewService(
+		ordersStorage, businessMetrics, envBox.Logger.With(zap.String("type", "orders")),
+	)
+	reviewsService := reviewssvc.NewService(reviewsrepo.NewPostgres(envBox.PGXPool), ordersStorage)
+	webhooksService := provideWebhooksService(envBox, businessMetrics)
+	telegramService := telegramsvc.NewService(
+		telegramrepo.NewPostgres(envBox.PGXPool), envBox.Config.TelegramConfig.InviteTTL,
+	)
+
+	accessTokens, err := identity.NewTokens(
+		envBox.Config.AuthConfig.JWTSecret, envBox.Config.ServiceName, envBox.Config.AuthConfig.AccessTokenTTL,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create access tokens | %w", err)
+	}
+
+	if !accessTokens.Enabled() {
+		envBox.Logger.Warn("jwt secret is not set, user login is disabled")
+	}
+
+	usersService := userssvc.NewService(
+		usersrepo.NewPostgres(envBox.PGXPool), accessTokens, envBox.Config.AuthConfig.RefreshTokenTTL,
+	)
+
+	apiKeysService := apikeyssvc.NewService(
+		apikeysrepo.NewPostgres(envBox.PGXPool),
+		envBox.Config.AuthConfig.APIKeyCacheTTL, envBox.Config.AuthConfig.APIKeyRotationGracePeriod,
+	)
+	authenticator := &authenticators{accessTokens: accessTokens, apiKeys: apiKeysService}
+
+	authPolicies := interceptors.NewAuthPolicies()
+	staticTokens := interceptors.NewStaticTokens(
+		envBox.Config.AuthConfig.ClientTokens, envBox.Config.AuthConfig.AdminTokens,
+	)
+	rateLimits := interceptors.NewLiveRateLimits(provideRateLimits(&envBox.Config.RateLimitConfig))
 
 	validator := rpcvalidator.MustValidate()
-	unaryInterceptors := provideUnaryGRPCInterceptors(envBox)
-	streamInterceptors := provideStreamGRPCInterceptors()
+	unaryRateLimitInterceptor, streamRateLimitInterceptor := provideRateLimitInterceptors(envBox, rateLimits)
+	unaryInterceptors := provideUnaryGRPCInterceptors(
+		envBox, unaryRateLimitInterceptor, authPolicies, staticTokens, authenticator, tenantsService,
+	)
+	streamInterceptors := provideStreamGRPCInterceptors(
+		envBox, streamRateLimitInterceptor, authPolicies, staticTokens, authenticator, tenantsService,
+	)
 
 	ordersHandlers := &orders.Handlers{OrdersService: ordersService}
 	reviewsHandlers := &reviews.Handlers{ReviewsService: reviewsService}
+	webhooksHandlers := &webhooks.Handlers{WebhooksService: webhooksService}
+	telegramHandlers := &telegram.Handlers{TelegramService: telegramService}
+	usersHandlers := &users.Handlers{UsersService: usersService}
+	apiKeysHandlers := &apikeys.Handlers{APIKeysService: apiKeysService}
 
-	telegramBot, err := provideTelegramBot(envBox)
+	telegramBot, err := provideTelegramBot(
+		ctx, envBox, businessMetrics, ordersHandlers, telegramHandlers, tenantsService,
+	)
 	if err != nil {
 		return nil, err
 	}
 
 	grpcServer := provideGRPCServer(
-		ctx, envBox, ordersHandlers, reviewsHandlers,
-		telegramBot, validator, unaryInterceptors, streamInterceptors,
+		ctx, envBox, ordersHandlers, reviewsHandlers, webhooksHandlers, telegramHandlers, usersHandlers,
+		apiKeysHandlers, telegramBot, validator, unaryInterceptors, streamInterceptors,
 	)
 
+	if err = authPolicies.Load(grpcServer.Services()); err != nil {
+		return nil, fmt.Errorf("failed to load auth policies | %w", err)
+	}
+
+	if err = provideTelegramWebhook(envBox, grpcServer, telegramBot); err != nil {
+		return nil, err
+	}
+
+	var smsService *smssvc.Service
+	if envBox.Config.SMSConfig.Enabled {
+		if smsService, err = provideSMSService(envBox, grpcServer); err != nil {
+			return nil, err
+		}
+	}
+
+	outboxDispatcher, err := provideOutboxDispatcher(
+		envBox, businessMetrics, ordersHandlers, telegramBot, smsService, webhooksService, tenantsService,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	healthChecker, err := provideHealthChecker(envBox, grpcServer, outboxDispatcher, telegramBot)
+	if err != nil {
+		return nil, err
+	}
+
 	metricsServer := provideMetricsServer(envBox)
 
 	return &Resources{
-		OrdersService:  ordersService,
-		ReviewsService: reviewsService,
+		OrdersStorage: ordersStorage,
+
+		OrdersService:   ordersService,
+		ReviewsService:  reviewsService,
+		WebhooksService: webhooksService,
+		TelegramService: telegramService,
+		UsersService:    usersService,
+		APIKeysService:  apiKeysService,
+		TenantsService:  tenantsService,
 
 		Validator: validator,
 
+		StaticTokens: staticTokens,
+		RateLimits:   rateLimits,
+
 		UnaryGRPCServerInterceptors:  unaryInterceptors,
 		StreamGRPCServerInterceptors: streamInterceptors,
 
 		GRPCServer:    grpcServer,
 		TelegramBot:   telegramBot,
 		MetricsServer: metricsServer,
+		HealthChecker: healthChecker,
+
+		SMSService:       smsService,
+		OutboxDispatcher: outboxDispatcher,
+		BusinessMetrics:  businessMetrics,
 	}, nil
 }
 
@@ -82,6 +230,10 @@ func provideGRPCServer(
 	envBox *Env,
 	ordersHandlers *orders.Handlers,
 	reviewsHandlers *reviews.Handlers,
+	webhooksHandlers *webhooks.Handlers,
+	telegramHandlers *telegram.Handlers,
+	usersHandlers *users.Handlers,
+	apiKeysHandlers *apikeys.Handlers,
 	telegramBot TelegramBotInterface,
 	validator *validatorv10.Validate,
 	unaryInterceptors []grpc.UnaryServerInterceptor,
@@ -91,34 +243,203 @@ func provideGRPCServer(
 		ctx,
 		envBox.Config.GRPCServerListenPort,
 		&server.NewServerOptions{
-			ServiceName:         envBox.Config.ServiceName,
-			OrdersGRPCHandlers:  ordersHandlers,
-			ReviewsGRPCHandlers: reviewsHandlers,
-			NewOrderNotifier:    orderNotifier(telegramBot),
-			Validator:           validator,
-			Logger:              envBox.Logger,
-			UnaryInterceptors:   unaryInterceptors,
-			StreamInterceptors:  streamInterceptors,
+			ServiceName:          envBox.Config.ServiceName,
+			OrdersGRPCHandlers:   ordersHandlers,
+			ReviewsGRPCHandlers:  reviewsHandlers,
+			WebhooksGRPCHandlers: webhooksHandlers,
+			TelegramGRPCHandlers: telegramHandlers,
+			AuthGRPCHandlers:     usersHandlers,
+			APIKeysGRPCHandlers:  apiKeysHandlers,
+			CaptchaVerifier:      provideCaptchaVerifier(envBox),
+			MinFormFillTime:      envBox.Config.BotProtectionConfig.MinFormFillTime,
+			RequireFormStartedAt: envBox.Config.BotProtectionConfig.RequireFormStartedAt,
+			TrustedProxyHops:     envBox.Config.TrustedProxyHops,
+			CORSAllowedOrigins:   envBox.Config.CORSAllowedOrigins,
+			Validator:            validator,
+			Logger:               envBox.Logger,
+			UnaryInterceptors:    unaryInterceptors,
+			StreamInterceptors:   streamInterceptors,
 		},
 	)
 }
 
-func provideUnaryGRPCInterceptors(envBox *Env) []grpc.UnaryServerInterceptor {
+func provideWebhooksService(envBox *Env, businessMetrics *metrics.Business) *webhookssvc.Service {
+	cfg := envBox.Config.WebhooksConfig
+
+	return webhookssvc.NewService(
+		webhooksrepo.NewPostgres(envBox.PGXPool), businessMetrics, envBox.Logger.With(zap.String("type", "webhooks")),
+		webhookssvc.Options{
+			PollInterval: cfg.PollInterval,
+			BatchSize:    cfg.BatchSize,
+			Lease:        cfg.Lease,
+			Timeout:      cfg.Timeout,
+			MaxAttempts:  cfg.MaxAttempts,
+			BaseBackoff:  cfg.BaseBackoff,
+			MaxBackoff:   cfg.MaxBackoff,
+		},
+	)
+}
+
+func provideCaptchaVerifier(envBox *Env) server.CaptchaVerifier {
+	cfg := envBox.Config.BotProtectionConfig
+
+	if !cfg.CaptchaEnabled {
+		return nil
+	}
+
+	switch cfg.CaptchaProvider {
+	case config.CaptchaProviderLocal:
+		return captcha.NewLocalVerifier(cfg.CaptchaLocalToken)
+	default:
+		return captcha.NewHTTPVerifier(cfg.CaptchaVerifyURL, cfg.CaptchaSecret, cfg.CaptchaTimeout)
+	}
+}
+
+func provideUnaryGRPCInterceptors(
+	envBox *Env, rateLimitInterceptor grpc.UnaryServerInterceptor,
+	authPolicies *interceptors.AuthPolicies, staticTokens *interceptors.StaticTokens,
+	authenticator interceptors.Authenticator, tenants interceptors.TenantResolver,
+) []grpc.UnaryServerInterceptor {
 	logger := envBox.Logger.With(zap.String("type", "unary"))
 
 	return []grpc.UnaryServerInterceptor{
 		interceptors.UnaryServerMetricsInterceptor(envBox.Config.MetricsConfig.Enabled, envBox.Config.ServiceName),
 		interceptors.UnaryServerTraceInterceptor(envBox.Tracer, envBox.Config.ServiceName),
 		interceptors.UnaryServerLogInterceptor(logger, envBox.Config.Debug),
-		interceptors.UnaryServerAuthInterceptor(
-			envBox.Config.AuthConfig.ClientTokens, envBox.Config.AuthConfig.AdminTokens,
-		),
+		rateLimitInterceptor,
+		interceptors.UnaryServerAuthInterceptor(logger, authPolicies, staticTokens, authenticator),
+		interceptors.UnaryServerTenantInterceptor(logger, tenants),
+		provideIdempotencyInterceptor(envBox, logger),
 		interceptors.UnaryServerPanicsInterceptor(logger, envBox.Config.ServiceName),
 	}
 }
 
-func provideStreamGRPCInterceptors() []grpc.StreamServerInterceptor {
-	return []grpc.StreamServerInterceptor{}
+func provideIdempotencyInterceptor(envBox *Env, logger *zap.Logger) grpc.UnaryServerInterceptor {
+	cfg := envBox.Config.IdempotencyConfig
+
+	if !cfg.Enabled {
+		return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+			return handler(ctx, req)
+		}
+	}
+
+	return interceptors.UnaryServerIdempotencyInterceptor(
+		logger, &idempotencyStore{storage: idempotencyrepo.NewPostgres(envBox.PGXPool, cfg.Window, cfg.Lease)},
+		cfg.Methods, cfg.Lease,
+	)
+}
+
+// idempotencyStore adapts the repository records to the interceptor contract.
+type idempotencyStore struct {
+	storage *idempotencyrepo.Postgres
+}
+
+func (s *idempotencyStore) Reserve(
+	ctx context.Context, key, method, requestHash string,
+) (*interceptors.IdempotentResponse, bool, error) {
+	record, reserved, err := s.storage.Reserve(ctx, key, method, requestHash)
+	if err != nil || reserved {
+		return nil, reserved, err
+	}
+
+	return &interceptors.IdempotentResponse{
+		RequestHash:  record.RequestHash,
+		ResponseType: record.ResponseType,
+		Response:     record.Response,
+	}, false, nil
+}
+
+func (s *idempotencyStore) Extend(ctx context.Context, key, method string) error {
+	return s.storage.Extend(ctx, key, method)
+}
+
+func (s *idempotencyStore) Complete(ctx context.Context, key, method, responseType string, response []byte) error {
+	return s.storage.Complete(ctx, key, method, responseType, response)
+}
+
+func (s *idempotencyStore) Release(ctx context.Context, key, method string) error {
+	return s.storage.Release(ctx, key, method)
+}
+
+// authenticators adapts api keys and access tokens to the interceptor contract.
+type authenticators struct {
+	accessTokens *identity.Tokens
+	apiKeys      *apikeyssvc.Service
+}
+
+func (c *authenticators) Authenticate(ctx context.Context, token string) (*identity.Identity, error) {
+	if !apikeyssvc.IsKey(token) {
+		return c.accessTokens.Parse(token)
+	}
+
+	key, err := c.apiKeys.Verify(ctx, token)
+	if err != nil {
+		if errors.Is(err, apikeyssvc.ErrInvalidKey) {
+			return nil, fmt.Errorf("%w | %w", identity.ErrInvalidToken, err)
+		}
+
+		return nil, err
+	}
+
+	return &identity.Identity{APIKeyID: key.ID, TenantID: key.TenantID, Scopes: key.Scopes}, nil
+}
+
+// provideRateLimitInterceptors unary calls and streams share the limiter.
+func provideRateLimitInterceptors(
+	envBox *Env, limits *interceptors.LiveRateLimits,
+) (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+	cfg := envBox.Config.RateLimitConfig
+
+	if !cfg.Enabled {
+		unary := func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+			return handler(ctx, req)
+		}
+		stream := func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			return handler(srv, ss)
+		}
+
+		return unary, stream
+	}
+
+	logger := envBox.Logger.With(zap.String("type", "rate_limit"))
+
+	var limiter interceptors.RateLimiter
+	switch cfg.Backend {
+	case config.RateLimitBackendPostgres:
+		limiter = ratelimitsrepo.NewPostgres(envBox.PGXPool)
+	default:
+		limiter = interceptors.NewMemoryRateLimiter()
+	}
+
+	return interceptors.UnaryServerRateLimitInterceptor(logger, limiter, limits, envBox.Config.TrustedProxyHops),
+		interceptors.StreamServerRateLimitInterceptor(logger, limiter, limits, envBox.Config.TrustedProxyHops)
+}
+
+func provideRateLimits(cfg *config.RateLimitConfig) interceptors.RateLimits {
+	return interceptors.RateLimits{
+		Window:    cfg.Window,
+		PerMethod: cfg.PerMethod,
+		PerToken:  cfg.PerToken,
+		PerIP:     cfg.PerIP,
+	}
+}
+
+func provideStreamGRPCInterceptors(
+	envBox *Env, rateLimitInterceptor grpc.StreamServerInterceptor,
+	authPolicies *interceptors.AuthPolicies, staticTokens *interceptors.StaticTokens,
+	authenticator interceptors.Authenticator, tenants interceptors.TenantResolver,
+) []grpc.StreamServerInterceptor {
+	logger := envBox.Logger.With(zap.String("type", "stream"))
+
+	return []grpc.StreamServerInterceptor{
+		interceptors.StreamServerMetricsInterceptor(envBox.Config.MetricsConfig.Enabled, envBox.Config.ServiceName),
+		interceptors.StreamServerTraceInterceptor(envBox.Tracer, envBox.Config.ServiceName),
+		interceptors.StreamServerLogInterceptor(logger, envBox.Config.Debug),
+		rateLimitInterceptor,
+		interceptors.StreamServerAuthInterceptor(logger, authPolicies, staticTokens, authenticator),
+		interceptors.StreamServerTenantInterceptor(logger, tenants),
+		interceptors.StreamServerPanicsInterceptor(logger, envBox.Config.ServiceName),
+	}
 }
 
 func provideMetricsServer(envBox *Env) *server.MetricsServer {
@@ -127,23 +448,142 @@ func provideMetricsServer(envBox *Env) *server.MetricsServer {
 	)
 }
 
-func orderNotifier(bot TelegramBotInterface) func(*orders.Order) {
-	return bot.NotifyNewOrder
-}
+func provideTelegramBot(
+	ctx context.Context, envBox *Env, businessMetrics *metrics.Business,
+	ordersHandlers *orders.Handlers, telegramHandlers *telegram.Handlers, tenants *tenantssvc.Service,
+) (TelegramBotInterface, error) {
+	cfg := envBox.Config.TelegramConfig
 
-func provideTelegramBot(envBox *Env) (TelegramBotInterface, error) {
-	if !envBox.Config.TelegramConfig.Enabled {
+	if !cfg.Enabled {
 		return server.NewNoopTelegramBot(), nil
 	}
 
-	telegramBot, err := server.NewTelegramBot(
-		envBox.Logger,
-		envBox.Config.TelegramConfig.Token, envBox.Config.TelegramConfig.Timeout,
-		envBox.Config.TelegramConfig.AllowedChatIDs,
-	)
+	// chats allowed by the config belong to the default tenant, others are invited by tenant admins
+	defaultTenant, err := tenants.Default(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get default tenant | %w", err)
+	}
+
+	revoked, err := telegramHandlers.Bootstrap(tenant.WithTenant(ctx, defaultTenant), cfg.AllowedChatIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bootstrap telegram chats | %w", err)
+	}
+
+	logRevokedChats(envBox.Logger, revoked)
+
+	location, err := time.LoadLocation(cfg.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load telegram timezone | %w", err)
+	}
+
+	digests, err := provideDigestSchedule(&cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	var webhook *server.TelegramWebhook
+
+	switch cfg.Mode {
+	case config.TelegramModePolling:
+	case config.TelegramModeWebhook:
+		if webhook, err = provideTelegramWebhookOptions(&cfg); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unknown telegram mode %q", cfg.Mode)
+	}
+
+	telegramBot, err := server.NewTelegramBot(&server.TelegramBotOptions{
+		Token:                 cfg.Token,
+		Timeout:               cfg.Timeout,
+		Webhook:               webhook,
+		Location:              location,
+		Digests:               *digests,
+		OrdersHandlers:        ordersHandlers,
+		SubscriptionsHandlers: telegramHandlers,
+		Tenants:               tenants,
+		Metrics:               businessMetrics,
+		Logger:                envBox.Logger,
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	return telegramBot, nil
 }
+
+func provideTelegramWebhookOptions(cfg *config.TelegramConfig) (*server.TelegramWebhook, error) {
+	if cfg.WebhookURL == "" {
+		return nil, errors.New("telegram webhook url is required in webhook mode")
+	}
+
+	if !telegramSecretPattern.MatchString(cfg.WebhookSecret) {
+		return nil, errors.New("telegram webhook secret must be 1-256 characters of A-Z, a-z, 0-9, _ and -")
+	}
+
+	return &server.TelegramWebhook{PublicURL: cfg.WebhookURL, Secret: cfg.WebhookSecret}, nil
+}
+
+// provideTelegramWebhook serves telegram updates on the http server in webhook mode.
+func provideTelegramWebhook(envBox *Env, grpcServer *server.Server, telegramBot TelegramBotInterface) error {
+	cfg := envBox.Config.TelegramConfig
+
+	if !cfg.Enabled || cfg.Mode != config.TelegramModeWebhook {
+		return nil
+	}
+
+	if err := grpcServer.HandleHTTP(http.MethodPost, cfg.WebhookPath, telegramBot.UpdatesHandler()); err != nil {
+		return fmt.Errorf("failed to register telegram webhook | %w", err)
+	}
+
+	return nil
+}
+
+func provideDigestSchedule(cfg *config.TelegramConfig) (*server.DigestSchedule, error) {
+	schedule := &server.DigestSchedule{}
+
+	if cfg.DailyDigestAt != "" {
+		dailyAt, err := parseClock(cfg.DailyDigestAt)
+		if err != nil {
+			return nil, fmt.Errorf("invalid daily digest time | %w", err)
+		}
+
+		schedule.Daily, schedule.DailyAt = true, dailyAt
+	}
+
+	if cfg.WeeklyDigestAt != "" {
+		weeklyAt, err := parseClock(cfg.WeeklyDigestAt)
+		if err != nil {
+			return nil, fmt.Errorf("invalid weekly digest time | %w", err)
+		}
+
+		weeklyDay, err := parseWeekday(cfg.WeeklyDigestDay)
+		if err != nil {
+			return nil, fmt.Errorf("invalid weekly digest day | %w", err)
+		}
+
+		schedule.Weekly, schedule.WeeklyDay, schedule.WeeklyAt = true, weeklyDay, weeklyAt
+	}
+
+	return schedule, nil
+}
+
+// parseClock turns HH:MM into the offset from midnight.
+func parseClock(clock string) (time.Duration, error) {
+	t, err := time.Parse("15:04", clock)
+	if err != nil {
+		return 0, err
+	}
+
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+func parseWeekday(day string) (time.Weekday, error) {
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		if strings.EqualFold(weekday.String(), day) {
+			return weekday, nil
+		}
+	}
+
+	return time.Sunday, fmt.Errorf("unknown weekday %q", day)
+}
@@ -0,0 +1,69 @@
+package box
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/ingvarmattis/moving/gen/servergrpc/server"
+	"github.com/ingvarmattis/moving/src/infra/config"
+	"github.com/ingvarmattis/moving/src/infra/sms"
+	smsrepo "github.com/ingvarmattis/moving/src/repositories/sms"
+	smssvc "github.com/ingvarmattis/moving/src/services/sms"
+)
+
+// smsStatusCallbackPath must match the path of MOVING_SERVICE_SMS_STATUS_CALLBACK_URL.
+const smsStatusCallbackPath = "/v1/sms/status"
+
+func provideSMSService(envBox *Env, grpcServer *server.Server) (*smssvc.Service, error) {
+	cfg := envBox.Config.SMSConfig
+
+	location, err := time.LoadLocation(cfg.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sms timezone | %w", err)
+	}
+
+	templates, err := sms.NewTemplates(cfg.TemplatesDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load sms templates | %w", err)
+	}
+
+	logger := envBox.Logger.With(zap.String("type", "sms"))
+	storage := smsrepo.NewPostgres(envBox.PGXPool)
+
+	var sender sms.SMSSender
+
+	switch cfg.Provider {
+	case config.SMSProviderTwilio:
+		twilio := sms.NewTwilioSender(sms.TwilioOptions{
+			BaseURL:           cfg.BaseURL,
+			AccountSID:        cfg.AccountSID,
+			AuthToken:         cfg.AuthToken,
+			From:              cfg.From,
+			StatusCallbackURL: cfg.StatusCallbackURL,
+			Timeout:           cfg.Timeout,
+		})
+
+		if cfg.StatusCallbackURL != "" {
+			if err = grpcServer.HandleHTTP(
+				http.MethodPost, smsStatusCallbackPath, twilio.StatusCallbackHandler(logger, storage),
+			); err != nil {
+				return nil, fmt.Errorf("failed to register sms status callback | %w", err)
+			}
+		}
+
+		sender = twilio
+	default:
+		sender = sms.NewFakeSender(logger)
+	}
+
+	return smssvc.NewService(storage, sender, templates, logger, smssvc.Options{
+		DefaultCountryCode: cfg.DefaultCountryCode,
+		ReminderHour:       cfg.ReminderHour,
+		Location:           location,
+		ReminderInterval:   cfg.ReminderInterval,
+		MaxAttempts:        cfg.MaxAttempts,
+	}), nil
+}
@@ -0,0 +1,82 @@
+package captcha
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// HTTPVerifier checks challenge tokens against a siteverify endpoint.
+// Cloudflare Turnstile, reCAPTCHA and hCaptcha share the same request and response format.
+type HTTPVerifier struct {
+	client    *http.Client
+	verifyURL string
+	secret    string
+}
+
+func NewHTTPVerifier(verifyURL, secret string, timeout time.Duration) *HTTPVerifier {
+	return &HTTPVerifier{
+		client:    &http.Client{Timeout: timeout},
+		verifyURL: verifyURL,
+		secret:    secret,
+	}
+}
+
+func (v *HTTPVerifier) Verify(ctx context.Context, token, remoteIP string) (bool, error) {
+	if token == "" {
+		return false, nil
+	}
+
+	form := url.Values{}
+	form.Set("secret", v.secret)
+	form.Set("response", token)
+
+	if remoteIP != "" {
+		form.Set("remoteip", remoteIP)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, v.verifyURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return false, fmt.Errorf("failed to build verify request | %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("failed to verify captcha | %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("unexpected verify status %d", resp.StatusCode)
+	}
+
+	var result struct {
+		Success bool `json:"success"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return false, fmt.Errorf("failed to decode verify response | %w", err)
+	}
+
+	return result.Success, nil
+}
+
+// LocalVerifier is a stand-in for local development and tests.
+// It accepts only the configured token and never calls external services.
+type LocalVerifier struct {
+	token string
+}
+
+func NewLocalVerifier(token string) *LocalVerifier {
+	return &LocalVerifier{token: token}
+}
+
+func (v *LocalVerifier) Verify(_ context.Context, token, _ string) (bool, error) {
+	return token != "" && subtle.ConstantTimeCompare([]byte(token), []byte(v.token)) == 1, nil
+}
@@ -8,30 +8,60 @@ import (
 	"github.com/kelseyhightower/envconfig"
 )
 
+// Config is read once on start, on SIGHUP only LogLevel, CORSAllowedOrigins, static auth tokens,
+// rate limits and Telegram chat ids are applied again.
 type Config struct {
-	Debug bool `envconfig:"MOVING_SERVICE_DEBUG" default:"false"`
+	Debug    bool   `envconfig:"MOVING_SERVICE_DEBUG" default:"false"`
+	LogLevel string `envconfig:"MOVING_SERVICE_LOG_LEVEL" default:"debug"`
 
 	GRPCServerListenPort int `envconfig:"MOVING_SERVICE_GRPC_SERVER_LISTEN_PORT" required:"true"`
 	HTTPServerListenPort int `envconfig:"MOVING_SERVICE_HTTP_SERVER_LISTEN_PORT" required:"true"`
 
+	// CORSAllowedOrigins "*" allows any origin.
+	CORSAllowedOrigins []string `envconfig:"MOVING_SERVICE_CORS_ALLOWED_ORIGINS" default:"*"`
+
+	// TrustedProxyHops is the number of proxies in front of the service appending to X-Forwarded-For,
+	// the client ip is taken that many entries from the right. It is 1 for the swarm proxy, with 0 behind
+	// a proxy every client gets the proxy ip and shares one rate limit bucket.
+	TrustedProxyHops int `envconfig:"MOVING_SERVICE_TRUSTED_PROXY_HOPS" default:"1"`
+
 	HostName    string `envconfig:"MOVING_SERVICE_HOST_NAME"`
 	ServiceName string `envconfig:"MOVING_SERVICE_SERVICE_NAME"`
 
-	PostgresConfig PostgresConfig
-	MetricsConfig  MetricsConfig
-	TracingConfig  TracingConfig
-	AuthConfig     AuthConfig
-	TelegramConfig TelegramConfig
+	PostgresConfig      PostgresConfig
+	HealthConfig        HealthConfig
+	ShutdownConfig      ShutdownConfig
+	MetricsConfig       MetricsConfig
+	TracingConfig       TracingConfig
+	AuthConfig          AuthConfig
+	TenantConfig        TenantConfig
+	RateLimitConfig     RateLimitConfig
+	BotProtectionConfig BotProtectionConfig
+	IdempotencyConfig   IdempotencyConfig
+	OutboxConfig        OutboxConfig
+	MailerConfig        MailerConfig
+	SMSConfig           SMSConfig
+	WebhooksConfig      WebhooksConfig
+	TelegramConfig      TelegramConfig
 }
 
-func FromEnv() (*Config, error) {
+// Load reads the environment on top of the config file from MOVING_SERVICE_CONFIG_FILE and secret files,
+// it is called again to reload the config.
+func Load() (*Config, error) {
+	layeredMu.Lock()
+	defer layeredMu.Unlock()
+
+	if err := applyFiles(); err != nil {
+		return nil, fmt.Errorf("error while reading config files | %w", err)
+	}
+
 	cfg := &Config{}
 
 	if hostName, err := os.Hostname(); err == nil {
 		cfg.HostName = hostName
 	}
 
-	if err := envconfig.Process("MOVING_SERVICE", cfg); err != nil {
+	if err := envconfig.Process(envPrefix, cfg); err != nil {
 		return nil, fmt.Errorf("error while parsing environment variables | %w", err)
 	}
 
@@ -42,9 +72,27 @@ type PostgresConfig struct {
 	ConnectionConfig string `envconfig:"MOVING_SERVICE_POSTGRES_URL" required:"true"`
 }
 
+// HealthConfig the service is not ready while postgres is down, an outbox lag over OutboxMaxLag
+// and telegram failures are reported without taking the service out of rotation.
+type HealthConfig struct {
+	Interval     time.Duration `envconfig:"MOVING_SERVICE_HEALTH_CHECK_INTERVAL" default:"10s"`
+	Timeout      time.Duration `envconfig:"MOVING_SERVICE_HEALTH_CHECK_TIMEOUT" default:"3s"`
+	OutboxMaxLag time.Duration `envconfig:"MOVING_SERVICE_HEALTH_OUTBOX_MAX_LAG" default:"5m"`
+}
+
+// ShutdownConfig Timeout bounds the whole shutdown, work still running then is cut off.
+// ReadinessDelay is how long the service keeps serving after it is marked not ready,
+// it gives load balancers time to notice.
+type ShutdownConfig struct {
+	Timeout        time.Duration `envconfig:"MOVING_SERVICE_SHUTDOWN_TIMEOUT" default:"30s"`
+	ReadinessDelay time.Duration `envconfig:"MOVING_SERVICE_SHUTDOWN_READINESS_DELAY" default:"5s"`
+}
+
 type MetricsConfig struct {
 	Enabled bool `envconfig:"MOVING_SERVICE_METRICS_ENABLED" required:"true"`
 	Port    int  `envconfig:"MOVING_SERVICE_HTTP_METRICS_SERVER_LISTEN_PORT" required:"true"`
+
+	OpenOrdersRefreshInterval time.Duration `envconfig:"MOVING_SERVICE_METRICS_OPEN_ORDERS_REFRESH_INTERVAL" default:"1m"`
 }
 
 type TracingConfig struct {
@@ -53,14 +101,160 @@ type TracingConfig struct {
 	UseTLS  bool   `envconfig:"MOVING_SERVICE_OPENTELEMETRY_USE_TLS" required:"true"`
 }
 
+// AuthConfig static tokens are reloadable and kept for bootstrap, admin tokens have every scope and client tokens
+// have the scopes of the site. Users log in for access tokens signed with JWTSecret, without it Login and
+// RefreshToken fail and only static tokens and api keys authenticate. Integrations use api keys stored in the database.
 type AuthConfig struct {
-	ClientTokens []string `envconfig:"MOVING_SERVICE_CLIENT_AUTH_TOKENS" required:"true"`
-	AdminTokens  []string `envconfig:"MOVING_SERVICE_ADMIN_AUTH_TOKENS" required:"true"`
+	ClientTokens []string `envconfig:"MOVING_SERVICE_CLIENT_AUTH_TOKENS"`
+	AdminTokens  []string `envconfig:"MOVING_SERVICE_ADMIN_AUTH_TOKENS"`
+
+	JWTSecret       string        `envconfig:"MOVING_SERVICE_AUTH_JWT_SECRET"`
+	AccessTokenTTL  time.Duration `envconfig:"MOVING_SERVICE_AUTH_ACCESS_TOKEN_TTL" default:"15m"`
+	RefreshTokenTTL time.Duration `envconfig:"MOVING_SERVICE_AUTH_REFRESH_TOKEN_TTL" default:"720h"`
+
+	APIKeyCacheTTL            time.Duration `envconfig:"MOVING_SERVICE_AUTH_API_KEY_CACHE_TTL" default:"1m"`
+	APIKeyRotationGracePeriod time.Duration `envconfig:"MOVING_SERVICE_AUTH_API_KEY_ROTATION_GRACE_PERIOD" default:"24h"`
+}
+
+// TenantConfig Default is the slug of the tenant serving hosts no tenant claims.
+type TenantConfig struct {
+	Default  string        `envconfig:"MOVING_SERVICE_TENANT_DEFAULT" default:"default"`
+	CacheTTL time.Duration `envconfig:"MOVING_SERVICE_TENANT_CACHE_TTL" default:"1m"`
+}
+
+const (
+	RateLimitBackendMemory   = "memory"
+	RateLimitBackendPostgres = "postgres"
+)
+
+// RateLimitConfig limits are keyed by short method name, "*" matches any method.
+// Enabled and Backend are read once on start, limits and Window are reloadable.
+type RateLimitConfig struct {
+	Enabled bool          `envconfig:"MOVING_SERVICE_RATE_LIMIT_ENABLED" default:"false"`
+	Backend string        `envconfig:"MOVING_SERVICE_RATE_LIMIT_BACKEND" default:"memory"`
+	Window  time.Duration `envconfig:"MOVING_SERVICE_RATE_LIMIT_WINDOW" default:"1m"`
+
+	PerMethod map[string]int `envconfig:"MOVING_SERVICE_RATE_LIMIT_PER_METHOD" default:"CreateOrder:300"`
+	PerToken  map[string]int `envconfig:"MOVING_SERVICE_RATE_LIMIT_PER_TOKEN" default:"CreateOrder:120"`
+	PerIP     map[string]int `envconfig:"MOVING_SERVICE_RATE_LIMIT_PER_IP" default:"CreateOrder:5,Login:10"`
+}
+
+// IdempotencyConfig methods are short method names honoring the Idempotency-Key header.
+// Lease is how long a key stays reserved after its replica stopped extending it, e.g. crashed.
+type IdempotencyConfig struct {
+	Enabled bool          `envconfig:"MOVING_SERVICE_IDEMPOTENCY_ENABLED" default:"true"`
+	Window  time.Duration `envconfig:"MOVING_SERVICE_IDEMPOTENCY_WINDOW" default:"24h"`
+	Lease   time.Duration `envconfig:"MOVING_SERVICE_IDEMPOTENCY_LEASE" default:"30s"`
+	Methods []string      `envconfig:"MOVING_SERVICE_IDEMPOTENCY_METHODS" default:"CreateOrder,UpdateOrder"`
 }
 
+const (
+	CaptchaProviderHTTP  = "http"
+	CaptchaProviderLocal = "local"
+)
+
+// BotProtectionConfig orders failing the checks are stored, but marked as spam.
+type BotProtectionConfig struct {
+	// MinFormFillTime is checked for orders sent with form_started_at, 0 disables the check.
+	MinFormFillTime time.Duration `envconfig:"MOVING_SERVICE_BOT_PROTECTION_MIN_FORM_FILL_TIME" default:"3s"`
+	// RequireFormStartedAt flags orders without form_started_at too fast, turn it on once every client sends it.
+	RequireFormStartedAt bool `envconfig:"MOVING_SERVICE_BOT_PROTECTION_REQUIRE_FORM_STARTED_AT" default:"false"`
+
+	CaptchaEnabled    bool          `envconfig:"MOVING_SERVICE_CAPTCHA_ENABLED" default:"false"`
+	CaptchaProvider   string        `envconfig:"MOVING_SERVICE_CAPTCHA_PROVIDER" default:"http"`
+	CaptchaVerifyURL  string        `envconfig:"MOVING_SERVICE_CAPTCHA_VERIFY_URL" default:"https://challenges.cloudflare.com/turnstile/v0/siteverify"`
+	CaptchaSecret     string        `envconfig:"MOVING_SERVICE_CAPTCHA_SECRET"`
+	CaptchaTimeout    time.Duration `envconfig:"MOVING_SERVICE_CAPTCHA_TIMEOUT" default:"5s"`
+	CaptchaLocalToken string        `envconfig:"MOVING_SERVICE_CAPTCHA_LOCAL_TOKEN"`
+}
+
+// OutboxConfig an event failed MaxAttempts times is moved to dead letters.
+type OutboxConfig struct {
+	PollInterval time.Duration `envconfig:"MOVING_SERVICE_OUTBOX_POLL_INTERVAL" default:"1s"`
+	BatchSize    int           `envconfig:"MOVING_SERVICE_OUTBOX_BATCH_SIZE" default:"50"`
+	Lease        time.Duration `envconfig:"MOVING_SERVICE_OUTBOX_LEASE" default:"1m"`
+	MaxAttempts  int           `envconfig:"MOVING_SERVICE_OUTBOX_MAX_ATTEMPTS" default:"10"`
+	BaseBackoff  time.Duration `envconfig:"MOVING_SERVICE_OUTBOX_BASE_BACKOFF" default:"5s"`
+	MaxBackoff   time.Duration `envconfig:"MOVING_SERVICE_OUTBOX_MAX_BACKOFF" default:"30m"`
+	Retention    time.Duration `envconfig:"MOVING_SERVICE_OUTBOX_RETENTION" default:"168h"`
+}
+
+// MailerConfig templates from TemplatesDir replace the built-in ones with the same file name.
+type MailerConfig struct {
+	Enabled      bool          `envconfig:"MOVING_SERVICE_MAILER_ENABLED" default:"false"`
+	SMTPHost     string        `envconfig:"MOVING_SERVICE_MAILER_SMTP_HOST" default:"localhost"`
+	SMTPPort     int           `envconfig:"MOVING_SERVICE_MAILER_SMTP_PORT" default:"1025"`
+	SMTPUsername string        `envconfig:"MOVING_SERVICE_MAILER_SMTP_USERNAME"`
+	SMTPPassword string        `envconfig:"MOVING_SERVICE_MAILER_SMTP_PASSWORD"`
+	StartTLS     bool          `envconfig:"MOVING_SERVICE_MAILER_SMTP_STARTTLS" default:"false"`
+	Timeout      time.Duration `envconfig:"MOVING_SERVICE_MAILER_TIMEOUT" default:"10s"`
+	From         string        `envconfig:"MOVING_SERVICE_MAILER_FROM" default:"Moving <no-reply@localhost>"`
+	ReplyTo      string        `envconfig:"MOVING_SERVICE_MAILER_REPLY_TO"`
+	OfficeInbox  []string      `envconfig:"MOVING_SERVICE_MAILER_OFFICE_INBOX"`
+	TemplatesDir string        `envconfig:"MOVING_SERVICE_MAILER_TEMPLATES_DIR"`
+}
+
+const (
+	SMSProviderTwilio = "twilio"
+	SMSProviderFake   = "fake"
+)
+
+// SMSConfig the fake provider only logs messages.
+type SMSConfig struct {
+	Enabled            bool          `envconfig:"MOVING_SERVICE_SMS_ENABLED" default:"false"`
+	Provider           string        `envconfig:"MOVING_SERVICE_SMS_PROVIDER" default:"fake"`
+	BaseURL            string        `envconfig:"MOVING_SERVICE_SMS_BASE_URL" default:"https://api.twilio.com"`
+	AccountSID         string        `envconfig:"MOVING_SERVICE_SMS_ACCOUNT_SID"`
+	AuthToken          string        `envconfig:"MOVING_SERVICE_SMS_AUTH_TOKEN"`
+	From               string        `envconfig:"MOVING_SERVICE_SMS_FROM"`
+	StatusCallbackURL  string        `envconfig:"MOVING_SERVICE_SMS_STATUS_CALLBACK_URL"`
+	Timeout            time.Duration `envconfig:"MOVING_SERVICE_SMS_TIMEOUT" default:"10s"`
+	DefaultCountryCode string        `envconfig:"MOVING_SERVICE_SMS_DEFAULT_COUNTRY_CODE" default:"1"`
+	TemplatesDir       string        `envconfig:"MOVING_SERVICE_SMS_TEMPLATES_DIR"`
+	ReminderHour       int           `envconfig:"MOVING_SERVICE_SMS_REMINDER_HOUR" default:"10"`
+	ReminderInterval   time.Duration `envconfig:"MOVING_SERVICE_SMS_REMINDER_INTERVAL" default:"15m"`
+	MaxAttempts        int           `envconfig:"MOVING_SERVICE_SMS_MAX_ATTEMPTS" default:"3"`
+	Timezone           string        `envconfig:"MOVING_SERVICE_SMS_TIMEZONE" default:"UTC"`
+}
+
+// WebhooksConfig subscriptions can be managed while delivery is disabled, events are not recorded then.
+type WebhooksConfig struct {
+	Enabled      bool          `envconfig:"MOVING_SERVICE_WEBHOOKS_ENABLED" default:"true"`
+	PollInterval time.Duration `envconfig:"MOVING_SERVICE_WEBHOOKS_POLL_INTERVAL" default:"1s"`
+	BatchSize    int           `envconfig:"MOVING_SERVICE_WEBHOOKS_BATCH_SIZE" default:"20"`
+	Lease        time.Duration `envconfig:"MOVING_SERVICE_WEBHOOKS_LEASE" default:"1m"`
+	Timeout      time.Duration `envconfig:"MOVING_SERVICE_WEBHOOKS_TIMEOUT" default:"10s"`
+	MaxAttempts  int           `envconfig:"MOVING_SERVICE_WEBHOOKS_MAX_ATTEMPTS" default:"8"`
+	BaseBackoff  time.Duration `envconfig:"MOVING_SERVICE_WEBHOOKS_BASE_BACKOFF" default:"10s"`
+	MaxBackoff   time.Duration `envconfig:"MOVING_SERVICE_WEBHOOKS_MAX_BACKOFF" default:"1h"`
+}
+
+const (
+	TelegramModePolling = "polling"
+	TelegramModeWebhook = "webhook"
+)
+
+// TelegramConfig Mode is how updates are received, polling works with one replica only.
+// Webhook mode serves WebhookPath on the http server, WebhookURL is its public address.
 type TelegramConfig struct {
-	Enabled        bool          `envconfig:"MOVING_SERVICE_TELEGRAM_ENABLED" required:"true"`
-	Token          string        `envconfig:"MOVING_SERVICE_TELEGRAM_TOKEN" required:"true"`
-	Timeout        time.Duration `envconfig:"MOVING_SERVICE_TELEGRAM_TIMEOUT" required:"true"`
-	AllowedChatIDs []int64       `envconfig:"MOVING_SERVICE_TELEGRAM_ALLOWED_CHAT_IDS" required:"true"`
+	Enabled  bool          `envconfig:"MOVING_SERVICE_TELEGRAM_ENABLED" required:"true"`
+	Token    string        `envconfig:"MOVING_SERVICE_TELEGRAM_TOKEN" required:"true"`
+	Timeout  time.Duration `envconfig:"MOVING_SERVICE_TELEGRAM_TIMEOUT" required:"true"`
+	Timezone string        `envconfig:"MOVING_SERVICE_TELEGRAM_TIMEZONE" default:"UTC"`
+
+	Mode          string `envconfig:"MOVING_SERVICE_TELEGRAM_MODE" default:"polling"`
+	WebhookURL    string `envconfig:"MOVING_SERVICE_TELEGRAM_WEBHOOK_URL"`
+	WebhookPath   string `envconfig:"MOVING_SERVICE_TELEGRAM_WEBHOOK_PATH" default:"/telegram/updates"`
+	WebhookSecret string `envconfig:"MOVING_SERVICE_TELEGRAM_WEBHOOK_SECRET"`
+
+	// AllowedChatIDs are subscribed on start and reload, chats removed from them are revoked. An empty list
+	// revokes nothing, the last chat is unsubscribed with RevokeTelegramChat.
+	// Other chats join with invites from InviteTTL.
+	AllowedChatIDs []int64       `envconfig:"MOVING_SERVICE_TELEGRAM_ALLOWED_CHAT_IDS"`
+	InviteTTL      time.Duration `envconfig:"MOVING_SERVICE_TELEGRAM_INVITE_TTL" default:"24h"`
+
+	// Digest times are HH:MM in Timezone, an empty time disables the digest.
+	DailyDigestAt   string `envconfig:"MOVING_SERVICE_TELEGRAM_DAILY_DIGEST_AT" default:"18:00"`
+	WeeklyDigestDay string `envconfig:"MOVING_SERVICE_TELEGRAM_WEEKLY_DIGEST_DAY" default:"sunday"`
+	WeeklyDigestAt  string `envconfig:"MOVING_SERVICE_TELEGRAM_WEEKLY_DIGEST_AT" default:"18:00"`
 }
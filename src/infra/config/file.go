@@ -0,0 +1,232 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/BurntSushi/toml"
+	"go.yaml.in/yaml/v3"
+)
+
+const (
+	envPrefix = "MOVING_SERVICE"
+
+	// ConfigFileEnv names the yaml or toml config file, the format is taken from the extension.
+	ConfigFileEnv = envPrefix + "_CONFIG_FILE"
+
+	// secretFileSuffix marks a variable holding the path of a file with the value, e.g. a docker secret
+	// from /run/secrets. MOVING_SERVICE_AUTH_JWT_SECRET_FILE is read into MOVING_SERVICE_AUTH_JWT_SECRET.
+	secretFileSuffix = "_FILE"
+)
+
+var errUnknownKey = errors.New("unknown config key")
+
+// layered are the variables set from the config file and secret files by the last load,
+// they are dropped before the next load so only the real environment overrides the files.
+var (
+	layeredMu sync.Mutex
+	layered   []string
+)
+
+// applyFiles sets variables from the config file and secret files unless the environment has them.
+// Keys of the file are variable names without the MOVING_SERVICE_ prefix in any case, nested tables
+// are joined with "_", so telegram: {allowed_chat_ids: [1, 2]} is MOVING_SERVICE_TELEGRAM_ALLOWED_CHAT_IDS=1,2.
+func applyFiles() error {
+	for _, name := range layered {
+		if err := os.Unsetenv(name); err != nil {
+			return fmt.Errorf("failed to unset %s | %w", name, err)
+		}
+	}
+
+	layered = nil
+
+	fileValues := map[string]string{}
+
+	if path := os.Getenv(ConfigFileEnv); path != "" {
+		var err error
+		if fileValues, err = readConfigFile(path); err != nil {
+			return err
+		}
+	}
+
+	values := map[string]string{}
+
+	for name := range knownVariables() {
+		if _, ok := os.LookupEnv(name); ok {
+			continue
+		}
+
+		if secretPath, ok := os.LookupEnv(name + secretFileSuffix); ok {
+			secret, err := readSecret(secretPath)
+			if err != nil {
+				return err
+			}
+
+			values[name] = secret
+
+			continue
+		}
+
+		if value, ok := fileValues[name]; ok {
+			values[name] = value
+			continue
+		}
+
+		if secretPath, ok := fileValues[name+secretFileSuffix]; ok {
+			secret, err := readSecret(secretPath)
+			if err != nil {
+				return err
+			}
+
+			values[name] = secret
+		}
+	}
+
+	for name, value := range values {
+		if err := os.Setenv(name, value); err != nil {
+			return fmt.Errorf("failed to set %s | %w", name, err)
+		}
+
+		layered = append(layered, name)
+	}
+
+	return nil
+}
+
+func readConfigFile(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file | %w", err)
+	}
+
+	tree := map[string]any{}
+
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &tree)
+	case ".toml":
+		err = toml.Unmarshal(data, &tree)
+	default:
+		return nil, fmt.Errorf("unsupported config file format %q", ext)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse config file %s | %w", path, err)
+	}
+
+	values := map[string]string{}
+	if err = flatten(envPrefix, tree, knownVariables(), values); err != nil {
+		return nil, fmt.Errorf("invalid config file %s | %w", path, err)
+	}
+
+	return values, nil
+}
+
+// flatten turns the tree into variables, a value of a known variable is formatted the way envconfig parses it.
+func flatten(prefix string, tree map[string]any, known map[string]struct{}, values map[string]string) error {
+	for key, value := range tree {
+		name := prefix + "_" + strings.ToUpper(key)
+
+		_, isVariable := known[name]
+		_, isSecretFile := known[strings.TrimSuffix(name, secretFileSuffix)]
+
+		if isVariable || (isSecretFile && strings.HasSuffix(name, secretFileSuffix)) {
+			formatted, err := formatValue(value)
+			if err != nil {
+				return fmt.Errorf("%s | %w", key, err)
+			}
+
+			values[name] = formatted
+
+			continue
+		}
+
+		subtree, ok := value.(map[string]any)
+		if !ok {
+			return fmt.Errorf("%w %s", errUnknownKey, strings.ToLower(strings.TrimPrefix(name, envPrefix+"_")))
+		}
+
+		if err := flatten(name, subtree, known, values); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// formatValue lists are joined with commas and maps are written as key:value pairs.
+func formatValue(value any) (string, error) {
+	switch v := value.(type) {
+	case []any:
+		items := make([]string, 0, len(v))
+
+		for _, item := range v {
+			formatted, err := formatValue(item)
+			if err != nil {
+				return "", err
+			}
+
+			items = append(items, formatted)
+		}
+
+		return strings.Join(items, ","), nil
+	case map[string]any:
+		pairs := make([]string, 0, len(v))
+
+		for key, item := range v {
+			formatted, err := formatValue(item)
+			if err != nil {
+				return "", err
+			}
+
+			pairs = append(pairs, key+":"+formatted)
+		}
+
+		slices.Sort(pairs)
+
+		return strings.Join(pairs, ","), nil
+	case nil:
+		return "", nil
+	default:
+		return fmt.Sprint(v), nil
+	}
+}
+
+// readSecret trims the trailing newline editors and docker secrets leave.
+func readSecret(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read secret file | %w", err)
+	}
+
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
+
+// knownVariables are the variables of Config taken from the envconfig tags.
+func knownVariables() map[string]struct{} {
+	known := map[string]struct{}{}
+	collectVariables(reflect.TypeFor[Config](), known)
+
+	return known
+}
+
+func collectVariables(t reflect.Type, known map[string]struct{}) {
+	for i := range t.NumField() {
+		field := t.Field(i)
+
+		if name := field.Tag.Get("envconfig"); name != "" {
+			known[strings.ToUpper(name)] = struct{}{}
+			continue
+		}
+
+		if field.Type.Kind() == reflect.Struct {
+			collectVariables(field.Type, known)
+		}
+	}
+}
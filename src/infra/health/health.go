@@ -0,0 +1,241 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	statusOK           = "ok"
+	statusFailed       = "failed"
+	statusStarting     = "starting"
+	statusUnavailable  = "unavailable"
+	statusShuttingDown = "shutting_down"
+)
+
+var errCheckTimeout = errors.New("check timed out")
+
+// Check returns an error when the dependency is not usable.
+type Check func(ctx context.Context) error
+
+// StatusSetter is the grpc health server.
+type StatusSetter interface {
+	SetServingStatus(service string, status healthpb.HealthCheckResponse_ServingStatus)
+}
+
+type Options struct {
+	Interval time.Duration
+	Timeout  time.Duration
+}
+
+type check struct {
+	name     string
+	run      Check
+	required bool
+}
+
+// Checker runs the checks periodically and reports them to the grpc health server and on /readyz.
+// Every check has its own grpc health service named after the check, the services of the Checker
+// are SERVING while the required checks pass and the service is not shutting down.
+type Checker struct {
+	setter   StatusSetter
+	services []string
+	logger   *zap.Logger
+	opts     Options
+	checks   []check
+
+	mu      sync.RWMutex
+	results map[string]error
+	checked bool
+
+	shuttingDown atomic.Bool
+}
+
+func NewChecker(setter StatusSetter, logger *zap.Logger, opts Options, services ...string) *Checker {
+	c := &Checker{
+		setter:   setter,
+		services: services,
+		logger:   logger,
+		opts:     opts,
+		results:  make(map[string]error),
+	}
+
+	c.setServing(false)
+
+	return c
+}
+
+// Add must be called before Run, a failed optional check is reported but keeps the service ready.
+func (c *Checker) Add(name string, required bool, run Check) {
+	c.checks = append(c.checks, check{name: name, run: run, required: required})
+	c.setter.SetServingStatus(name, healthpb.HealthCheckResponse_NOT_SERVING)
+}
+
+// Run checks the dependencies until ctx is done.
+func (c *Checker) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.opts.Interval)
+	defer ticker.Stop()
+
+	for {
+		c.checkAll(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Shutdown marks the service not ready for good, so traffic is drained before the servers stop.
+func (c *Checker) Shutdown() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.shuttingDown.Store(true)
+	c.setServing(false)
+}
+
+func (c *Checker) checkAll(ctx context.Context) {
+	results := make(map[string]error, len(c.checks))
+
+	for _, check := range c.checks {
+		err := c.runCheck(ctx, check.run)
+		results[check.name] = err
+
+		status := healthpb.HealthCheckResponse_SERVING
+		if err != nil {
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+
+		c.setter.SetServingStatus(check.name, status)
+	}
+
+	if ctx.Err() != nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, check := range c.checks {
+		previous, err := c.results[check.name], results[check.name]
+
+		switch {
+		case err != nil && previous == nil:
+			c.logger.Warn("health check failed", zap.String("check", check.name), zap.Error(err))
+		case err == nil && previous != nil:
+			c.logger.Info("health check recovered", zap.String("check", check.name))
+		}
+	}
+
+	c.results, c.checked = results, true
+
+	c.setServing(c.ready())
+}
+
+// runCheck a check ignoring ctx is abandoned after the timeout.
+func (c *Checker) runCheck(ctx context.Context, run Check) error {
+	ctx, cancel := context.WithTimeout(ctx, c.opts.Timeout)
+	defer cancel()
+
+	result := make(chan error, 1)
+	go func() { result <- run(ctx) }()
+
+	select {
+	case err := <-result:
+		return err
+	case <-ctx.Done():
+		return errCheckTimeout
+	}
+}
+
+func (c *Checker) ready() bool {
+	if c.shuttingDown.Load() || !c.checked {
+		return false
+	}
+
+	for _, check := range c.checks {
+		if check.required && c.results[check.name] != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (c *Checker) setServing(serving bool) {
+	status := healthpb.HealthCheckResponse_NOT_SERVING
+	if serving && !c.shuttingDown.Load() {
+		status = healthpb.HealthCheckResponse_SERVING
+	}
+
+	for _, service := range c.services {
+		c.setter.SetServingStatus(service, status)
+	}
+}
+
+type readiness struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks,omitempty"`
+}
+
+// LivenessHandler answers while the process serves http, dependencies are not checked,
+// so an outage of postgres does not get the service restarted.
+func (c *Checker) LivenessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, http.StatusOK, readiness{Status: statusOK})
+	})
+}
+
+// ReadinessHandler answers 503 until the required checks pass and from the start of shutdown.
+// Checks are reported as ok or failed only, the errors may tell internals and are logged by checkAll.
+func (c *Checker) ReadinessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		c.mu.RLock()
+
+		response := readiness{Status: statusOK, Checks: make(map[string]string, len(c.results))}
+		for name, err := range c.results {
+			response.Checks[name] = statusOK
+			if err != nil {
+				response.Checks[name] = statusFailed
+			}
+		}
+
+		ready := c.ready()
+
+		switch {
+		case c.shuttingDown.Load():
+			response.Status = statusShuttingDown
+		case !c.checked:
+			response.Status = statusStarting
+		case !ready:
+			response.Status = statusUnavailable
+		}
+
+		c.mu.RUnlock()
+
+		code := http.StatusOK
+		if !ready {
+			code = http.StatusServiceUnavailable
+		}
+
+		writeJSON(w, code, response)
+	})
+}
+
+func writeJSON(w http.ResponseWriter, code int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+
+	_ = json.NewEncoder(w).Encode(body)
+}
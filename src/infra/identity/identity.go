@@ -0,0 +1,77 @@
+package identity
+
+import (
+	"context"
+	"slices"
+)
+
+type Role string
+
+const (
+	RoleDispatcher Role = "dispatcher"
+	RoleManager    Role = "manager"
+	RoleOwner      Role = "owner"
+)
+
+// Roles are all roles a user may have.
+var Roles = []Role{RoleDispatcher, RoleManager, RoleOwner}
+
+// Identity is who makes the call, a user, an api key or a static admin token with both ids zero.
+// TenantID binds the identity to one tenant, zero lets it work with the tenant of the host.
+type Identity struct {
+	UserID   uint64
+	APIKeyID uint64
+	TenantID uint64
+	Roles    []Role
+	Scopes   []Scope
+}
+
+// Static is the identity of the static admin tokens, it has every role and scope.
+var Static = &Identity{Roles: Roles, Scopes: Scopes}
+
+func (i *Identity) HasScope(scope Scope) bool {
+	return slices.Contains(i.Scopes, scope)
+}
+
+// IsUser tells if the identity is a user, not an api key or a static token.
+func (i *Identity) IsUser() bool {
+	return i.UserID != 0
+}
+
+// IsBound tells if the identity may only work with its own tenant.
+func (i *Identity) IsBound() bool {
+	return i.TenantID != 0
+}
+
+type contextKey struct{}
+
+func WithIdentity(ctx context.Context, identity *Identity) context.Context {
+	return context.WithValue(ctx, contextKey{}, identity)
+}
+
+// FromContext returns the identity of a call authenticated with an admin token, an access token or an api key.
+func FromContext(ctx context.Context) (*Identity, bool) {
+	identity, ok := ctx.Value(contextKey{}).(*Identity)
+
+	return identity, ok
+}
+
+// UserID returns the id of the calling user, nil for api keys, static tokens and client calls.
+func UserID(ctx context.Context) *uint64 {
+	identity, ok := FromContext(ctx)
+	if !ok || !identity.IsUser() {
+		return nil
+	}
+
+	return &identity.UserID
+}
+
+// TenantID returns the tenant the caller is bound to, nil if it may work with any tenant.
+func TenantID(ctx context.Context) *uint64 {
+	identity, ok := FromContext(ctx)
+	if !ok || !identity.IsBound() {
+		return nil
+	}
+
+	return &identity.TenantID
+}
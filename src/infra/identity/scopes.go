@@ -0,0 +1,55 @@
+package identity
+
+import "slices"
+
+type Scope string
+
+const (
+	ScopeOrdersCreate    Scope = "orders:create"
+	ScopeOrdersRead      Scope = "orders:read"
+	ScopeOrdersWrite     Scope = "orders:write"
+	ScopeStatsRead       Scope = "stats:read"
+	ScopeReviewsRead     Scope = "reviews:read"
+	ScopeReviewsCreate   Scope = "reviews:create"
+	ScopeReviewsModerate Scope = "reviews:moderate"
+	ScopeWebhooksManage  Scope = "webhooks:manage"
+	ScopeTelegramManage  Scope = "telegram:manage"
+	ScopeUsersManage     Scope = "users:manage"
+	ScopeAPIKeysManage   Scope = "api_keys:manage"
+)
+
+// Scopes are all scopes an api key may be given.
+var Scopes = []Scope{
+	ScopeOrdersCreate, ScopeOrdersRead, ScopeOrdersWrite, ScopeStatsRead,
+	ScopeReviewsRead, ScopeReviewsCreate, ScopeReviewsModerate,
+	ScopeWebhooksManage, ScopeTelegramManage, ScopeUsersManage, ScopeAPIKeysManage,
+}
+
+// ClientScopes are what the site needs, they are given to the static client tokens.
+var ClientScopes = []Scope{ScopeOrdersCreate, ScopeReviewsRead, ScopeReviewsCreate}
+
+var roleScopes = map[Role][]Scope{
+	RoleDispatcher: {
+		ScopeOrdersCreate, ScopeOrdersRead, ScopeOrdersWrite, ScopeReviewsRead, ScopeReviewsCreate,
+	},
+	RoleManager: {
+		ScopeOrdersCreate, ScopeOrdersRead, ScopeOrdersWrite, ScopeStatsRead,
+		ScopeReviewsRead, ScopeReviewsCreate, ScopeReviewsModerate, ScopeTelegramManage,
+	},
+	RoleOwner: Scopes,
+}
+
+// ScopesOf returns the scopes granted by the roles.
+func ScopesOf(roles []Role) []Scope {
+	var scopes []Scope
+
+	for _, role := range roles {
+		for _, scope := range roleScopes[role] {
+			if !slices.Contains(scopes, scope) {
+				scopes = append(scopes, scope)
+			}
+		}
+	}
+
+	return scopes
+}
@@ -3,70 +3,187 @@ package interceptors
 import (
 	"context"
 	"errors"
+	"slices"
 	"strings"
+	"sync/atomic"
 
+	grpcmiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
+	rpc "github.com/ingvarmattis/moving/gen/servergrpc/moving"
+	"github.com/ingvarmattis/moving/src/infra/identity"
 	"github.com/ingvarmattis/moving/src/infra/utils"
 )
 
 var (
 	errNoAuthTokenProvided = errors.New("no auth token provided")
 	errInvalidAuthToken    = errors.New("invalid auth token")
+	errPermissionDenied    = errors.New("permission denied")
+	errAuthFailed          = errors.New("failed to authenticate")
 )
 
-var adminMethods = map[string]struct{}{
-	"/ingvarmattis.services.moving.v1.OrdersService/Orders":      {},
-	"/ingvarmattis.services.moving.v1.OrdersService/Order":       {},
-	"/ingvarmattis.services.moving.v1.OrdersService/UpdateOrder": {},
+const (
+	authKey      = "authorization"
+	bearerPrefix = "Bearer "
+
+	// streamTokenKey is set by the http gateway from the query or a cookie of a browser EventSource.
+	streamTokenKey = "stream-access-token"
+)
+
+// streamTokenMethods accept an access token in streamTokenKey when the call has no bearer token.
+// The token may end up in access logs with the url, so api keys and static tokens are refused there
+// and only the short-lived access tokens of users are accepted.
+var streamTokenMethods = map[string]struct{}{
+	rpc.OrdersService_WatchOrders_FullMethodName: {},
+}
+
+// Authenticator returns the identity of an access token or an api key,
+// the error wraps identity.ErrInvalidToken when the token is not valid.
+type Authenticator interface {
+	Authenticate(ctx context.Context, token string) (*identity.Identity, error)
+}
+
+// StaticTokens are the tokens from the config, they are replaced on config reload.
+type StaticTokens struct {
+	tokens atomic.Pointer[staticTokens]
 }
 
-func UnaryServerAuthInterceptor(clientTokens, adminTokens []string) grpc.UnaryServerInterceptor {
-	const (
-		authKey      = "authorization"
-		bearerPrefix = "Bearer "
-	)
+type staticTokens struct {
+	client map[string]struct{}
+	admin  map[string]struct{}
+}
+
+func NewStaticTokens(clientTokens, adminTokens []string) *StaticTokens {
+	t := &StaticTokens{}
+	t.Set(clientTokens, adminTokens)
+
+	return t
+}
+
+func (t *StaticTokens) Set(clientTokens, adminTokens []string) {
+	t.tokens.Store(&staticTokens{client: utils.ToMap(clientTokens), admin: utils.ToMap(adminTokens)})
+}
 
-	clientTokensMap := utils.ToMap(clientTokens)
-	adminTokensMap := utils.ToMap(adminTokens)
+func UnaryServerAuthInterceptor(
+	logger *zap.Logger, policies *AuthPolicies, staticTokens *StaticTokens, authenticator Authenticator,
+) grpc.UnaryServerInterceptor {
+	authorize := newAuthorizer(logger, policies, staticTokens, authenticator)
 
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
-		md, ok := metadata.FromIncomingContext(ctx)
+		ctx, err := authorize(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func StreamServerAuthInterceptor(
+	logger *zap.Logger, policies *AuthPolicies, staticTokens *StaticTokens, authenticator Authenticator,
+) grpc.StreamServerInterceptor {
+	authorize := newAuthorizer(logger, policies, staticTokens, authenticator)
+
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authorize(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		wrapped := grpcmiddleware.WrapServerStream(ss)
+		wrapped.WrappedContext = ctx
+
+		return handler(srv, wrapped)
+	}
+}
+
+// newAuthorizer checks the bearer token of the call against the policy of its method.
+// Static admin tokens have every scope, static client tokens have identity.ClientScopes.
+// Methods of streamTokenMethods also take an access token from streamTokenKey.
+// The identity of the caller is put into the returned context, see identity.FromContext.
+// Failures of the authenticator other than an invalid token are logged, the caller gets a generic error.
+func newAuthorizer(
+	logger *zap.Logger, policies *AuthPolicies, staticTokens *StaticTokens, authenticator Authenticator,
+) func(ctx context.Context, fullMethod string) (context.Context, error) {
+	return func(ctx context.Context, fullMethod string) (context.Context, error) {
+		policy, ok := policies.policy(fullMethod)
 		if !ok {
-			return nil, status.Error(codes.Unauthenticated, errNoAuthTokenProvided.Error())
+			return nil, status.Error(codes.PermissionDenied, errPermissionDenied.Error())
 		}
 
-		mdKey := md.Get(authKey)
+		if policy.public {
+			return ctx, nil
+		}
 
-		if len(mdKey) != 1 {
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok {
 			return nil, status.Error(codes.Unauthenticated, errNoAuthTokenProvided.Error())
 		}
 
-		if !strings.HasPrefix(mdKey[0], bearerPrefix) {
-			return nil, status.Error(codes.Unauthenticated, errInvalidAuthToken.Error())
+		token, fromStream, err := callToken(md, fullMethod)
+		if err != nil {
+			return nil, err
 		}
 
-		token := strings.TrimPrefix(mdKey[0], bearerPrefix)
+		static := staticTokens.tokens.Load()
+
+		if _, ok = static.admin[token]; ok && !fromStream {
+			return identity.WithIdentity(ctx, identity.Static), nil
+		}
+
+		if _, ok = static.client[token]; ok && !fromStream {
+			if policy.scope != "" && !slices.Contains(identity.ClientScopes, policy.scope) {
+				return nil, status.Error(codes.PermissionDenied, errPermissionDenied.Error())
+			}
+
+			return ctx, nil
+		}
 
-		if _, ok = adminMethods[info.FullMethod]; ok {
-			if _, ok = adminTokensMap[token]; !ok {
+		caller, err := authenticator.Authenticate(ctx, token)
+		if err != nil {
+			if errors.Is(err, identity.ErrInvalidToken) {
 				return nil, status.Error(codes.Unauthenticated, errInvalidAuthToken.Error())
 			}
 
-			return handler(ctx, req)
+			logger.Error("failed to authenticate", zap.Error(err), zap.String("method", fullMethod))
+
+			return nil, status.Error(codes.Internal, errAuthFailed.Error())
+		}
+
+		if fromStream && caller.UserID == 0 {
+			return nil, status.Error(codes.Unauthenticated, errInvalidAuthToken.Error())
 		}
 
-		if _, ok = adminTokensMap[token]; ok {
-			return handler(ctx, req)
+		if policy.scope != "" && !caller.HasScope(policy.scope) {
+			return nil, status.Error(codes.PermissionDenied, errPermissionDenied.Error())
 		}
 
-		if _, ok = clientTokensMap[token]; ok {
-			return handler(ctx, req)
+		return identity.WithIdentity(ctx, caller), nil
+	}
+}
+
+// callToken returns the bearer token of the call, or the stream token on streamTokenMethods
+// when there is no bearer token.
+func callToken(md metadata.MD, fullMethod string) (token string, fromStream bool, err error) {
+	values := md.Get(authKey)
+
+	if _, ok := streamTokenMethods[fullMethod]; ok && len(values) == 0 {
+		if streamToken := md.Get(streamTokenKey); len(streamToken) == 1 && streamToken[0] != "" {
+			return streamToken[0], true, nil
 		}
+	}
 
-		return nil, status.Error(codes.Unauthenticated, errInvalidAuthToken.Error())
+	if len(values) != 1 {
+		return "", false, status.Error(codes.Unauthenticated, errNoAuthTokenProvided.Error())
 	}
+
+	if !strings.HasPrefix(values[0], bearerPrefix) {
+		return "", false, status.Error(codes.Unauthenticated, errInvalidAuthToken.Error())
+	}
+
+	return strings.TrimPrefix(values[0], bearerPrefix), false, nil
 }
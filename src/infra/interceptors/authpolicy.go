@@ -0,0 +1,144 @@
+package interceptors
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	rpc "github.com/ingvarmattis/moving/gen/servergrpc/moving"
+	"github.com/ingvarmattis/moving/src/infra/identity"
+)
+
+var (
+	ErrNoAuthPolicy      = errors.New("no auth policy declared")
+	ErrInvalidAuthPolicy = errors.New("invalid auth policy")
+)
+
+// externalServices are registered from other packages and cannot declare the Auth option,
+// any authenticated caller may call them. Health is public, orchestrator probes send no token.
+var externalServices = map[string]authPolicy{
+	"grpc.health.v1.Health":                    {public: true},
+	"grpc.reflection.v1.ServerReflection":      {},
+	"grpc.reflection.v1alpha.ServerReflection": {},
+}
+
+type authPolicy struct {
+	public bool
+	// scope is empty for a method open to any authenticated caller.
+	scope identity.Scope
+}
+
+// AuthPolicies are the policies of the methods by full method name, declared with the Auth option in service.proto.
+// They are loaded once the services are registered and before the server is started, a method without
+// a loaded policy is denied.
+type AuthPolicies struct {
+	methods map[string]authPolicy
+}
+
+func NewAuthPolicies() *AuthPolicies {
+	return &AuthPolicies{methods: make(map[string]authPolicy)}
+}
+
+// Load reads the Auth option of every method of the services, it fails when a method has no policy
+// or a policy with an unknown scope.
+func (p *AuthPolicies) Load(services map[string]grpc.ServiceInfo) error {
+	methods := make(map[string]authPolicy)
+
+	var errs []error
+
+	for serviceName, info := range services {
+		for _, method := range info.Methods {
+			fullMethod := "/" + serviceName + "/" + method.Name
+
+			if policy, ok := externalServices[serviceName]; ok {
+				methods[fullMethod] = policy
+
+				continue
+			}
+
+			policy, err := methodPolicy(serviceName, method.Name)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s | %w", fullMethod, err))
+
+				continue
+			}
+
+			methods[fullMethod] = policy
+		}
+	}
+
+	if len(errs) > 0 {
+		slices.SortFunc(errs, func(a, b error) int { return strings.Compare(a.Error(), b.Error()) })
+
+		return fmt.Errorf("failed to read auth policies | %w", errors.Join(errs...))
+	}
+
+	p.methods = methods
+
+	return nil
+}
+
+// policy of the method, external services are looked up by their name.
+func (p *AuthPolicies) policy(fullMethod string) (authPolicy, bool) {
+	if policy, ok := p.methods[fullMethod]; ok {
+		return policy, true
+	}
+
+	serviceName, _, _ := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	if policy, ok := externalServices[serviceName]; ok {
+		return policy, true
+	}
+
+	return authPolicy{}, false
+}
+
+func methodPolicy(serviceName, methodName string) (authPolicy, error) {
+	descriptor, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(serviceName))
+	if err != nil {
+		return authPolicy{}, fmt.Errorf("failed to find service | %w", err)
+	}
+
+	service, ok := descriptor.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return authPolicy{}, fmt.Errorf("%s is not a service | %w", serviceName, ErrNoAuthPolicy)
+	}
+
+	method := service.Methods().ByName(protoreflect.Name(methodName))
+	if method == nil {
+		return authPolicy{}, ErrNoAuthPolicy
+	}
+
+	options := method.Options()
+	if options == nil || !proto.HasExtension(options, rpc.E_Auth) {
+		return authPolicy{}, ErrNoAuthPolicy
+	}
+
+	declared, ok := proto.GetExtension(options, rpc.E_Auth).(*rpc.AuthPolicy)
+	if !ok || declared == nil {
+		return authPolicy{}, ErrNoAuthPolicy
+	}
+
+	switch policy := declared.GetPolicy().(type) {
+	case *rpc.AuthPolicy_Public:
+		if !policy.Public {
+			return authPolicy{}, fmt.Errorf("public is false | %w", ErrInvalidAuthPolicy)
+		}
+
+		return authPolicy{public: true}, nil
+	case *rpc.AuthPolicy_Scope:
+		scope := identity.Scope(policy.Scope)
+		if !slices.Contains(identity.Scopes, scope) {
+			return authPolicy{}, fmt.Errorf("unknown scope %q | %w", policy.Scope, ErrInvalidAuthPolicy)
+		}
+
+		return authPolicy{scope: scope}, nil
+	default:
+		return authPolicy{}, ErrNoAuthPolicy
+	}
+}
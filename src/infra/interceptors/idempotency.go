@@ -0,0 +1,215 @@
+package interceptors
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	"github.com/ingvarmattis/moving/src/infra/tenant"
+	"github.com/ingvarmattis/moving/src/infra/utils"
+)
+
+// IdempotencyKeyMetadata is also forwarded by the gateway from the Idempotency-Key http header.
+const IdempotencyKeyMetadata = "idempotency-key"
+
+const maxIdempotencyKeyLength = 255
+
+var (
+	errIdempotencyKeyTooLong      = errors.New("idempotency key is too long")
+	errIdempotencyKeyReused       = errors.New("idempotency key was used with another request")
+	errIdempotencyKeyInProgress   = errors.New("request with this idempotency key is in progress")
+	errIdempotencyNotProtoRequest = errors.New("request is not a proto message")
+)
+
+// IdempotentResponse is a stored result for an idempotency key.
+// Response is nil while the original request is still in progress.
+type IdempotentResponse struct {
+	RequestHash  string
+	ResponseType string
+	Response     []byte
+}
+
+// IdempotencyStore keeps idempotency keys for a window.
+// Reserve reports true when the key is new, expired or its lease is over and now belongs to the caller,
+// otherwise it returns what was stored for the key. Extend renews the lease of a running request.
+type IdempotencyStore interface {
+	Reserve(ctx context.Context, key, method, requestHash string) (*IdempotentResponse, bool, error)
+	Extend(ctx context.Context, key, method string) error
+	Complete(ctx context.Context, key, method, responseType string, response []byte) error
+	Release(ctx context.Context, key, method string) error
+}
+
+// UnaryServerIdempotencyInterceptor replays stored responses for retried requests with the same key.
+// Only successful responses are stored, so a failed request may be retried with the same key.
+// There is no stream counterpart, a stream can not be replayed from a stored response.
+// A retry of a request still running gets Aborted, the reservation is extended every third of lease.
+func UnaryServerIdempotencyInterceptor(
+	logger *zap.Logger, store IdempotencyStore, methods []string, lease time.Duration,
+) grpc.UnaryServerInterceptor {
+	methodsMap := utils.ToMap(methods)
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		method := extractShortMethodName(info.FullMethod)
+
+		if _, ok := methodsMap[method]; !ok {
+			return handler(ctx, req)
+		}
+
+		idempotencyKey := metadataValue(ctx, IdempotencyKeyMetadata)
+		if idempotencyKey == "" {
+			return handler(ctx, req)
+		}
+
+		if len(idempotencyKey) > maxIdempotencyKeyLength {
+			return nil, status.Error(codes.InvalidArgument, errIdempotencyKeyTooLong.Error())
+		}
+
+		requestHash, err := hashRequest(req)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		// keys are scoped by client and tenant, so two clients can not read each other responses
+		key := hashKey(bearerToken(ctx)) + ":" + idempotencyKey
+		if t, ok := tenant.FromContext(ctx); ok {
+			key = t.Slug + ":" + key
+		}
+
+		stored, reserved, err := store.Reserve(ctx, key, method, requestHash)
+		if err != nil {
+			// fail open, a possible duplicate is better than a lost order
+			logger.Error("failed to reserve idempotency key", zap.Error(err), zap.String("method", method))
+			return handler(ctx, req)
+		}
+
+		if !reserved {
+			return replay(stored, requestHash)
+		}
+
+		stopExtending := extendReservation(ctx, logger, store, key, method, lease/3)
+		resp, err := handler(ctx, req)
+		stopExtending()
+
+		if err != nil {
+			if releaseErr := store.Release(ctx, key, method); releaseErr != nil {
+				logger.Error("failed to release idempotency key", zap.Error(releaseErr), zap.String("method", method))
+			}
+
+			return nil, err
+		}
+
+		if msg, ok := resp.(proto.Message); ok {
+			data, marshalErr := proto.Marshal(msg)
+			if marshalErr == nil {
+				marshalErr = store.Complete(ctx, key, method, string(msg.ProtoReflect().Descriptor().FullName()), data)
+			}
+
+			if marshalErr != nil {
+				logger.Error("failed to store idempotent response", zap.Error(marshalErr), zap.String("method", method))
+			}
+		}
+
+		return resp, nil
+	}
+}
+
+// extendReservation renews the lease of the key every interval until the returned func is called.
+func extendReservation(
+	ctx context.Context, logger *zap.Logger, store IdempotencyStore, key, method string, interval time.Duration,
+) func() {
+	if interval <= 0 {
+		return func() {}
+	}
+
+	done := make(chan struct{})
+	stopped := make(chan struct{})
+
+	go func() {
+		defer close(stopped)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := store.Extend(ctx, key, method); err != nil {
+					logger.Error("failed to extend idempotency key", zap.Error(err), zap.String("method", method))
+				}
+			}
+		}
+	}()
+
+	return func() {
+		close(done)
+		<-stopped
+	}
+}
+
+func replay(stored *IdempotentResponse, requestHash string) (any, error) {
+	if stored.RequestHash != requestHash {
+		return nil, status.Error(codes.FailedPrecondition, errIdempotencyKeyReused.Error())
+	}
+
+	if stored.Response == nil {
+		return nil, status.Error(codes.Aborted, errIdempotencyKeyInProgress.Error())
+	}
+
+	messageType, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(stored.ResponseType))
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to find response type | %v", err))
+	}
+
+	resp := messageType.New().Interface()
+	if err = proto.Unmarshal(stored.Response, resp); err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to unmarshal stored response | %v", err))
+	}
+
+	return resp, nil
+}
+
+func hashRequest(req any) (string, error) {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return "", errIdempotencyNotProtoRequest
+	}
+
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal request | %w", err)
+	}
+
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func metadataValue(ctx context.Context, key string) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	values := md.Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
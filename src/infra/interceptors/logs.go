@@ -7,46 +7,87 @@ import (
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+
+	"github.com/ingvarmattis/moving/src/infra/utils"
 )
 
 func UnaryServerLogInterceptor(logger *zap.Logger, debugMode bool) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
 		startTime := time.Now()
 
-		traceID := trace.SpanFromContext(ctx).SpanContext().TraceID()
-
 		resp, err := handler(ctx, req)
 
-		executionDuration := time.Since(startTime)
+		fields := requestLogFields(ctx, info.FullMethod, startTime, err)
 
-		var protocol string
-		if md, ok := metadata.FromIncomingContext(ctx); ok {
-			if len(md.Get("grpcgateway-user-agent")) > 0 {
-				protocol = "http"
-			} else {
-				protocol = "grpc"
-			}
+		if debugMode {
+			fields = append(fields, zap.Any("request", req), zap.Any("response", resp))
 		}
 
-		fields := []zap.Field{
-			zap.String("method", info.FullMethod),
-			zap.String("protocol", protocol),
-			zap.Duration("duration", executionDuration),
-			zap.String("status", status.Code(err).String()),
-		}
+		logger.Info("incoming request", fields...)
 
-		if traceID.IsValid() {
-			fields = append(fields, zap.String("traceID", traceID.String()))
-		}
+		return resp, err
+	}
+}
+
+// StreamServerLogInterceptor logs the stream when it ends, debug mode adds the number of messages.
+func StreamServerLogInterceptor(logger *zap.Logger, debugMode bool) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		startTime := time.Now()
+
+		stream := &countingServerStream{ServerStream: ss}
+
+		err := handler(srv, stream)
+
+		fields := requestLogFields(ss.Context(), info.FullMethod, startTime, err)
 
 		if debugMode {
-			fields = append(fields, zap.Any("request", req), zap.Any("response", resp))
+			fields = append(fields, zap.Int("received", stream.received), zap.Int("sent", stream.sent))
 		}
 
-		logger.Info("incoming request", fields...)
+		logger.Info("incoming stream", fields...)
 
-		return resp, err
+		return err
 	}
 }
+
+func requestLogFields(ctx context.Context, fullMethod string, startTime time.Time, err error) []zap.Field {
+	fields := []zap.Field{
+		zap.String("method", fullMethod),
+		zap.String("protocol", utils.RequestProtocol(ctx)),
+		zap.Duration("duration", time.Since(startTime)),
+		zap.String("status", status.Code(err).String()),
+	}
+
+	if traceID := trace.SpanFromContext(ctx).SpanContext().TraceID(); traceID.IsValid() {
+		fields = append(fields, zap.String("traceID", traceID.String()))
+	}
+
+	return fields
+}
+
+// countingServerStream counts messages of a stream, handlers use a stream from one goroutine.
+type countingServerStream struct {
+	grpc.ServerStream
+
+	received int
+	sent     int
+}
+
+func (s *countingServerStream) RecvMsg(m any) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil {
+		s.received++
+	}
+
+	return err
+}
+
+func (s *countingServerStream) SendMsg(m any) error {
+	err := s.ServerStream.SendMsg(m)
+	if err == nil {
+		s.sent++
+	}
+
+	return err
+}
@@ -2,13 +2,15 @@ package interceptors
 
 import (
 	"context"
+	"errors"
 	"strings"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+
+	"github.com/ingvarmattis/moving/src/infra/utils"
 )
 
 const methodNameUnknown = "unknown"
@@ -22,46 +24,84 @@ func UnaryServerMetricsInterceptor(enabled bool, serviceName string) grpc.UnaryS
 		}
 	}
 
+	observe := newRequestsObserver(serviceName)
+
+	return func(
+		ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+	) (any, error) {
+		start := time.Now()
+
+		resp, err := handler(ctx, req)
+
+		observe(ctx, info.FullMethod, start, err)
+
+		return resp, err
+	}
+}
+
+// StreamServerMetricsInterceptor observes whole streams, the duration is the time the stream was open.
+func StreamServerMetricsInterceptor(enabled bool, serviceName string) grpc.StreamServerInterceptor {
+	if !enabled {
+		return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			return handler(srv, ss)
+		}
+	}
+
+	observe := newRequestsObserver(serviceName)
+
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+
+		err := handler(srv, ss)
+
+		observe(ss.Context(), info.FullMethod, start, err)
+
+		return err
+	}
+}
+
+// newRequestsObserver unary and stream interceptors share the collectors.
+func newRequestsObserver(serviceName string) func(ctx context.Context, fullMethod string, start time.Time, err error) {
 	serviceName = strings.ReplaceAll(serviceName, "-", "_")
 
-	grpcDurations := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	grpcDurations := registerCollector(prometheus.NewHistogramVec(prometheus.HistogramOpts{
 		Name:    "responses_duration_seconds",
 		Help:    "Response time by method and error code.",
 		Buckets: []float64{.005, .01, .05, .1, .5, 1, 5, 10, 15, 20, 25, 30, 60, 90},
-	}, []string{"service", "subsystem", "method", "code"})
+	}, []string{"service", "subsystem", "method", "code"}))
 
-	grpcErrors := prometheus.NewCounterVec(prometheus.CounterOpts{
+	grpcErrors := registerCollector(prometheus.NewCounterVec(prometheus.CounterOpts{
 		Name: "error_requests_count",
 		Help: "Error requests count by method and error code.",
-	}, []string{"service", "subsystem", "method", "code"})
+	}, []string{"service", "subsystem", "method", "code"}))
 
-	prometheus.MustRegister(grpcDurations, grpcErrors)
+	return func(ctx context.Context, fullMethod string, start time.Time, err error) {
+		subsystem := utils.RequestProtocol(ctx)
 
-	return func(
-		ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
-	) (any, error) {
-		start := time.Now()
+		method := extractShortMethodName(fullMethod)
 
-		var subsystem string
-		if md, ok := metadata.FromIncomingContext(ctx); ok {
-			if len(md.Get("grpcgateway-user-agent")) > 0 {
-				subsystem = "http"
-			} else {
-				subsystem = "grpc"
-			}
-		}
-
-		method := extractShortMethodName(info.FullMethod)
-
-		resp, err := handler(ctx, req)
 		if err != nil {
 			grpcErrors.WithLabelValues(serviceName, subsystem, method, status.Code(err).String()).Inc()
 		}
 
 		grpcDurations.WithLabelValues(serviceName, subsystem, method, status.Code(err).String()).Observe(time.Since(start).Seconds())
+	}
+}
 
-		return resp, err
+// registerCollector returns the collector registered before under the same name, if any.
+func registerCollector[T prometheus.Collector](collector T) T {
+	if err := prometheus.Register(collector); err != nil {
+		var registered prometheus.AlreadyRegisteredError
+		if errors.As(err, &registered) {
+			if existing, ok := registered.ExistingCollector.(T); ok {
+				return existing
+			}
+		}
+
+		panic(err)
 	}
+
+	return collector
 }
 
 func extractShortMethodName(fullMethod string) string {
@@ -14,27 +14,13 @@ import (
 var ErrPanicHandled = errors.New("panic handled")
 
 func UnaryServerPanicsInterceptor(logger *zap.Logger, serviceName string) grpc.UnaryServerInterceptor {
-	serviceName = strings.ReplaceAll(serviceName, "-", "_")
-
-	panicsCounter := prometheus.NewCounterVec(prometheus.CounterOpts{
-		Namespace: serviceName,
-		Subsystem: "grpc",
-		Name:      "panics_count",
-		Help:      "Panics count by method.",
-	}, []string{"method"})
-
-	prometheus.MustRegister(panicsCounter)
+	recoverPanic := newPanicsRecoverer(logger, serviceName)
 
 	return func(
 		ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
 	) (resp any, err error) {
-		method := extractShortMethodName(info.FullMethod)
-
 		defer func() {
-			if r := recover(); r != nil {
-				logger.Warn("panic: " + string(debug.Stack()))
-				panicsCounter.WithLabelValues(method).Inc()
-
+			if recoverPanic(info.FullMethod, recover()) {
 				err = ErrPanicHandled
 				resp = nil
 			}
@@ -43,3 +29,40 @@ func UnaryServerPanicsInterceptor(logger *zap.Logger, serviceName string) grpc.U
 		return handler(ctx, req)
 	}
 }
+
+func StreamServerPanicsInterceptor(logger *zap.Logger, serviceName string) grpc.StreamServerInterceptor {
+	recoverPanic := newPanicsRecoverer(logger, serviceName)
+
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if recoverPanic(info.FullMethod, recover()) {
+				err = ErrPanicHandled
+			}
+		}()
+
+		return handler(srv, ss)
+	}
+}
+
+// newPanicsRecoverer logs and counts the recovered value, it tells if there was a panic.
+func newPanicsRecoverer(logger *zap.Logger, serviceName string) func(fullMethod string, recovered any) bool {
+	serviceName = strings.ReplaceAll(serviceName, "-", "_")
+
+	panicsCounter := registerCollector(prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: serviceName,
+		Subsystem: "grpc",
+		Name:      "panics_count",
+		Help:      "Panics count by method.",
+	}, []string{"method"}))
+
+	return func(fullMethod string, recovered any) bool {
+		if recovered == nil {
+			return false
+		}
+
+		logger.Warn("panic: " + string(debug.Stack()))
+		panicsCounter.WithLabelValues(extractShortMethodName(fullMethod)).Inc()
+
+		return true
+	}
+}
@@ -0,0 +1,196 @@
+package interceptors
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/ingvarmattis/moving/src/infra/utils"
+)
+
+// AnyMethod applies a limit to every method without its own entry.
+const AnyMethod = "*"
+
+var errRateLimitExceeded = errors.New("rate limit exceeded")
+
+// RateLimiter counts hits for a key inside a fixed window.
+// Implementations must be safe for concurrent use.
+type RateLimiter interface {
+	Allow(ctx context.Context, key string, limit int, window time.Duration) (bool, error)
+}
+
+// RateLimits are keyed by short method name, AnyMethod is used as a fallback.
+// A missing or non-positive limit disables the check.
+type RateLimits struct {
+	Window time.Duration
+
+	PerMethod map[string]int
+	PerToken  map[string]int
+	PerIP     map[string]int
+}
+
+// LiveRateLimits are the limits in use, they are replaced on config reload.
+type LiveRateLimits struct {
+	limits atomic.Pointer[RateLimits]
+}
+
+func NewLiveRateLimits(limits RateLimits) *LiveRateLimits {
+	l := &LiveRateLimits{}
+	l.Set(limits)
+
+	return l
+}
+
+func (l *LiveRateLimits) Set(limits RateLimits) {
+	l.limits.Store(&limits)
+}
+
+// UnaryServerRateLimitInterceptor trustedProxyHops is passed to utils.ClientIP for the per ip limits.
+func UnaryServerRateLimitInterceptor(
+	logger *zap.Logger, limiter RateLimiter, limits *LiveRateLimits, trustedProxyHops int,
+) grpc.UnaryServerInterceptor {
+	checkLimits := newRateLimitChecker(logger, limiter, limits, trustedProxyHops)
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := checkLimits(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerRateLimitInterceptor counts opened streams, messages inside a stream are not limited.
+func StreamServerRateLimitInterceptor(
+	logger *zap.Logger, limiter RateLimiter, limits *LiveRateLimits, trustedProxyHops int,
+) grpc.StreamServerInterceptor {
+	checkLimits := newRateLimitChecker(logger, limiter, limits, trustedProxyHops)
+
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := checkLimits(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+
+		return handler(srv, ss)
+	}
+}
+
+func newRateLimitChecker(
+	logger *zap.Logger, limiter RateLimiter, liveLimits *LiveRateLimits, trustedProxyHops int,
+) func(ctx context.Context, fullMethod string) error {
+	return func(ctx context.Context, fullMethod string) error {
+		method := extractShortMethodName(fullMethod)
+		limits := liveLimits.limits.Load()
+
+		checks := []struct {
+			key   string
+			limit int
+		}{
+			{key: "method:" + method, limit: limitFor(limits.PerMethod, method)},
+			{key: "token:" + method + ":" + hashKey(bearerToken(ctx)), limit: limitFor(limits.PerToken, method)},
+			{key: "ip:" + method + ":" + utils.ClientIP(ctx, trustedProxyHops), limit: limitFor(limits.PerIP, method)},
+		}
+
+		for _, check := range checks {
+			if check.limit <= 0 {
+				continue
+			}
+
+			allowed, err := limiter.Allow(ctx, check.key, check.limit, limits.Window)
+			if err != nil {
+				// fail open, losing requests is worse than letting some extra through
+				logger.Error("rate limiter failed", zap.Error(err), zap.String("method", method))
+				continue
+			}
+
+			if !allowed {
+				return status.Error(codes.ResourceExhausted, errRateLimitExceeded.Error())
+			}
+		}
+
+		return nil
+	}
+}
+
+func limitFor(limits map[string]int, method string) int {
+	if limit, ok := limits[method]; ok {
+		return limit
+	}
+
+	return limits[AnyMethod]
+}
+
+func bearerToken(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	values := md.Get(authKey)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return strings.TrimPrefix(values[0], bearerPrefix)
+}
+
+func hashKey(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:8])
+}
+
+// MemoryRateLimiter is a fixed window limiter kept in process memory.
+// It is enough for a single replica, use a shared storage for several ones.
+type MemoryRateLimiter struct {
+	mutex     sync.Mutex
+	windows   map[string]*rateWindow
+	lastPurge time.Time
+}
+
+type rateWindow struct {
+	start time.Time
+	hits  int
+}
+
+func NewMemoryRateLimiter() *MemoryRateLimiter {
+	return &MemoryRateLimiter{windows: make(map[string]*rateWindow)}
+}
+
+func (l *MemoryRateLimiter) Allow(_ context.Context, key string, limit int, window time.Duration) (bool, error) {
+	now := time.Now()
+	start := now.Truncate(window)
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if now.Sub(l.lastPurge) > window {
+		for k, w := range l.windows {
+			if w.start.Before(start) {
+				delete(l.windows, k)
+			}
+		}
+
+		l.lastPurge = now
+	}
+
+	w, ok := l.windows[key]
+	if !ok || !w.start.Equal(start) {
+		w = &rateWindow{start: start}
+		l.windows[key] = w
+	}
+
+	w.hits++
+
+	return w.hits <= limit, nil
+}
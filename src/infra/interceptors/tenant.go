@@ -0,0 +1,102 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	grpcmiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ingvarmattis/moving/src/infra/identity"
+	"github.com/ingvarmattis/moving/src/infra/tenant"
+)
+
+var errUnknownTenant = errors.New("unknown tenant")
+
+const (
+	// forwardedHostKey is set by the http gateway from the Host header.
+	forwardedHostKey = "x-forwarded-host"
+	authorityKey     = ":authority"
+)
+
+// TenantResolver finds the tenant of a bound identity by id and of other callers by the requested host.
+type TenantResolver interface {
+	TenantByID(ctx context.Context, id uint64) (*tenant.Tenant, error)
+	TenantByHost(ctx context.Context, host string) (*tenant.Tenant, error)
+}
+
+func UnaryServerTenantInterceptor(logger *zap.Logger, resolver TenantResolver) grpc.UnaryServerInterceptor {
+	resolve := newTenantResolver(logger, resolver)
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := resolve(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func StreamServerTenantInterceptor(logger *zap.Logger, resolver TenantResolver) grpc.StreamServerInterceptor {
+	resolve := newTenantResolver(logger, resolver)
+
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := resolve(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		wrapped := grpcmiddleware.WrapServerStream(ss)
+		wrapped.WrappedContext = ctx
+
+		return handler(srv, wrapped)
+	}
+}
+
+// newTenantResolver puts the tenant of the call into the returned context, see tenant.FromContext.
+// It must follow the auth interceptor: an identity bound to a tenant works with its tenant only,
+// whatever host it calls, other callers work with the tenant of the host.
+// Health and reflection services are not tenant scoped.
+func newTenantResolver(
+	logger *zap.Logger, resolver TenantResolver,
+) func(ctx context.Context, fullMethod string) (context.Context, error) {
+	return func(ctx context.Context, fullMethod string) (context.Context, error) {
+		serviceName, _, _ := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+		if _, ok := externalServices[serviceName]; ok {
+			return ctx, nil
+		}
+
+		var (
+			t   *tenant.Tenant
+			err error
+		)
+
+		if caller, ok := identity.FromContext(ctx); ok && caller.IsBound() {
+			t, err = resolver.TenantByID(ctx, caller.TenantID)
+		} else {
+			t, err = resolver.TenantByHost(ctx, requestHost(ctx))
+		}
+
+		if err != nil {
+			logger.Warn("failed to resolve tenant", zap.Error(err), zap.String("method", fullMethod))
+
+			return nil, status.Error(codes.NotFound, errUnknownTenant.Error())
+		}
+
+		return tenant.WithTenant(ctx, t), nil
+	}
+}
+
+// requestHost is the Host header of gateway calls and the authority of grpc calls.
+func requestHost(ctx context.Context) string {
+	if host := metadataValue(ctx, forwardedHostKey); host != "" {
+		return strings.TrimSpace(strings.Split(host, ",")[0])
+	}
+
+	return metadataValue(ctx, authorityKey)
+}
@@ -3,6 +3,7 @@ package interceptors
 import (
 	"context"
 
+	grpcmiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"go.opentelemetry.io/otel/attribute"
 	otelcodes "go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
@@ -11,11 +12,9 @@ import (
 
 func UnaryServerTraceInterceptor(tracer trace.Tracer, serviceName string) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
-		ctx, span := tracer.Start(ctx, info.FullMethod)
+		ctx, span := startSpan(ctx, tracer, info.FullMethod, serviceName)
 		defer span.End()
 
-		span.SetAttributes(attribute.String("product", serviceName))
-
 		resp, err := handler(ctx, req)
 
 		setSpanStatus(span, err)
@@ -24,6 +23,31 @@ func UnaryServerTraceInterceptor(tracer trace.Tracer, serviceName string) grpc.U
 	}
 }
 
+// StreamServerTraceInterceptor spans the whole stream, handlers get the span in the stream context.
+func StreamServerTraceInterceptor(tracer trace.Tracer, serviceName string) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, span := startSpan(ss.Context(), tracer, info.FullMethod, serviceName)
+		defer span.End()
+
+		stream := grpcmiddleware.WrapServerStream(ss)
+		stream.WrappedContext = ctx
+
+		err := handler(srv, stream)
+
+		setSpanStatus(span, err)
+
+		return err
+	}
+}
+
+func startSpan(ctx context.Context, tracer trace.Tracer, fullMethod, serviceName string) (context.Context, trace.Span) {
+	ctx, span := tracer.Start(ctx, fullMethod)
+
+	span.SetAttributes(attribute.String("product", serviceName))
+
+	return ctx, span
+}
+
 func setSpanStatus(span trace.Span, err error) {
 	if err != nil {
 		span.RecordError(err)
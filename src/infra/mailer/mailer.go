@@ -0,0 +1,288 @@
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	errNoRecipients   = errors.New("no recipients")
+	errInvalidAddress = errors.New("invalid address")
+	errInvalidHeader  = errors.New("header value contains a line break")
+)
+
+// Message is sent as multipart/alternative when both bodies are set.
+// FromName replaces the display name of the sender, the address stays the configured one.
+// To and ReplyTo are parsed as addresses, they come from customers and are never written to headers as is.
+type Message struct {
+	To       []string
+	FromName string
+	ReplyTo  string
+	Subject  string
+	Text     string
+	HTML     string
+}
+
+type Mailer interface {
+	Send(ctx context.Context, msg *Message) error
+}
+
+type SMTPOptions struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+	StartTLS bool
+	Timeout  time.Duration
+}
+
+// SMTPMailer opens a connection per message, the volume is too low to keep a pool.
+type SMTPMailer struct {
+	opts SMTPOptions
+	from *mail.Address
+}
+
+func NewSMTPMailer(opts SMTPOptions) (*SMTPMailer, error) {
+	from, err := mail.ParseAddress(opts.From)
+	if err != nil {
+		return nil, fmt.Errorf("invalid from address | %w", err)
+	}
+
+	return &SMTPMailer{opts: opts, from: from}, nil
+}
+
+func (m *SMTPMailer) Send(ctx context.Context, msg *Message) error {
+	if len(msg.To) == 0 {
+		return errNoRecipients
+	}
+
+	to, err := parseAddresses(msg.To)
+	if err != nil {
+		return err
+	}
+
+	data, err := m.build(msg, to)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, m.opts.Timeout)
+	defer cancel()
+
+	dialer := &net.Dialer{}
+
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(m.opts.Host, strconv.Itoa(m.opts.Port)))
+	if err != nil {
+		return fmt.Errorf("failed to connect to smtp server | %w", err)
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err = conn.SetDeadline(deadline); err != nil {
+			return fmt.Errorf("failed to set smtp deadline | %w", err)
+		}
+	}
+
+	client, err := smtp.NewClient(conn, m.opts.Host)
+	if err != nil {
+		return fmt.Errorf("failed to create smtp client | %w", err)
+	}
+	defer client.Close()
+
+	if m.opts.StartTLS {
+		if err = client.StartTLS(&tls.Config{ServerName: m.opts.Host, MinVersion: tls.VersionTLS12}); err != nil {
+			return fmt.Errorf("failed to start tls | %w", err)
+		}
+	}
+
+	if m.opts.Username != "" {
+		if err = client.Auth(smtp.PlainAuth("", m.opts.Username, m.opts.Password, m.opts.Host)); err != nil {
+			return fmt.Errorf("failed to authenticate | %w", err)
+		}
+	}
+
+	if err = client.Mail(m.from.Address); err != nil {
+		return fmt.Errorf("failed to set sender | %w", err)
+	}
+
+	for _, rcpt := range to {
+		if err = client.Rcpt(rcpt.Address); err != nil {
+			return fmt.Errorf("failed to set recipient | %w", err)
+		}
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("failed to start data | %w", err)
+	}
+
+	if _, err = w.Write(data); err != nil {
+		return fmt.Errorf("failed to write message | %w", err)
+	}
+
+	if err = w.Close(); err != nil {
+		return fmt.Errorf("failed to send message | %w", err)
+	}
+
+	return client.Quit()
+}
+
+func (m *SMTPMailer) build(msg *Message, to []*mail.Address) ([]byte, error) {
+	var buf bytes.Buffer
+
+	from := *m.from
+	if msg.FromName != "" {
+		from.Name = msg.FromName
+	}
+
+	recipients := make([]string, 0, len(to))
+	for _, rcpt := range to {
+		recipients = append(recipients, rcpt.String())
+	}
+
+	header := textproto.MIMEHeader{}
+	header.Set("From", from.String())
+	header.Set("To", strings.Join(recipients, ", "))
+	header.Set("Subject", mime.QEncoding.Encode("utf-8", msg.Subject))
+	header.Set("Date", time.Now().Format(time.RFC1123Z))
+	header.Set("Message-ID", m.messageID())
+	header.Set("MIME-Version", "1.0")
+
+	if msg.ReplyTo != "" {
+		replyTo, err := mail.ParseAddress(msg.ReplyTo)
+		if err != nil {
+			return nil, fmt.Errorf("%w reply-to | %w", errInvalidAddress, err)
+		}
+
+		header.Set("Reply-To", replyTo.String())
+	}
+
+	if msg.HTML == "" || msg.Text == "" {
+		contentType, body := "text/plain", msg.Text
+		if msg.HTML != "" {
+			contentType, body = "text/html", msg.HTML
+		}
+
+		header.Set("Content-Type", contentType+"; charset=utf-8")
+		header.Set("Content-Transfer-Encoding", "quoted-printable")
+
+		if err := writeHeader(&buf, header); err != nil {
+			return nil, err
+		}
+
+		if err := writeQuotedPrintable(&buf, body); err != nil {
+			return nil, err
+		}
+
+		return buf.Bytes(), nil
+	}
+
+	var parts bytes.Buffer
+
+	body := multipart.NewWriter(&parts)
+
+	for _, part := range []struct{ contentType, content string }{
+		{contentType: "text/plain", content: msg.Text},
+		{contentType: "text/html", content: msg.HTML},
+	} {
+		w, err := body.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType + "; charset=utf-8"},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create message part | %w", err)
+		}
+
+		if err = writeQuotedPrintable(w, part.content); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := body.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close message | %w", err)
+	}
+
+	header.Set("Content-Type", "multipart/alternative; boundary="+body.Boundary())
+
+	if err := writeHeader(&buf, header); err != nil {
+		return nil, err
+	}
+
+	buf.Write(parts.Bytes())
+
+	return buf.Bytes(), nil
+}
+
+func (m *SMTPMailer) messageID() string {
+	random := make([]byte, 16)
+	_, _ = rand.Read(random)
+
+	domain := m.from.Address[strings.LastIndex(m.from.Address, "@")+1:]
+
+	return "<" + hex.EncodeToString(random) + "@" + domain + ">"
+}
+
+func parseAddresses(list []string) ([]*mail.Address, error) {
+	addresses := make([]*mail.Address, 0, len(list))
+
+	for _, item := range list {
+		address, err := mail.ParseAddress(item)
+		if err != nil {
+			return nil, fmt.Errorf("%w %q | %w", errInvalidAddress, item, err)
+		}
+
+		addresses = append(addresses, address)
+	}
+
+	return addresses, nil
+}
+
+// writeHeader refuses line breaks in values, so a value cannot start a header of its own.
+func writeHeader(buf *bytes.Buffer, header textproto.MIMEHeader) error {
+	for _, key := range slices.Sorted(maps.Keys(header)) {
+		for _, value := range header[key] {
+			if strings.ContainsAny(value, "\r\n") {
+				return fmt.Errorf("%w %s", errInvalidHeader, key)
+			}
+
+			buf.WriteString(key + ": " + value + "\r\n")
+		}
+	}
+
+	buf.WriteString("\r\n")
+
+	return nil
+}
+
+func writeQuotedPrintable(w io.Writer, content string) error {
+	qp := quotedprintable.NewWriter(w)
+
+	if _, err := qp.Write([]byte(content)); err != nil {
+		return fmt.Errorf("failed to encode message | %w", err)
+	}
+
+	if err := qp.Close(); err != nil {
+		return fmt.Errorf("failed to encode message | %w", err)
+	}
+
+	return nil
+}
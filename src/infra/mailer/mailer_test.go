@@ -0,0 +1,171 @@
+package mailer
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"testing"
+	"time"
+)
+
+// smtpSession is what the fake server received in one connection.
+type smtpSession struct {
+	from string
+	rcpt []string
+	data string
+}
+
+// serveSMTP accepts one connection and speaks just enough smtp for net/smtp.
+func serveSMTP(t *testing.T) (port int, sessions <-chan smtpSession) {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+
+	t.Cleanup(func() { _ = listener.Close() })
+
+	received := make(chan smtpSession, 1)
+
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		_ = conn.SetDeadline(time.Now().Add(5 * time.Second))
+
+		r := textproto.NewReader(bufio.NewReader(conn))
+		w := textproto.NewWriter(bufio.NewWriter(conn))
+
+		var session smtpSession
+
+		_ = w.PrintfLine("220 localhost ESMTP")
+
+		for {
+			line, err := r.ReadLine()
+			if err != nil {
+				return
+			}
+
+			command := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+
+			switch command {
+			case "EHLO", "HELO":
+				_ = w.PrintfLine("250 localhost")
+			case "MAIL":
+				session.from = line
+				_ = w.PrintfLine("250 OK")
+			case "RCPT":
+				session.rcpt = append(session.rcpt, line)
+				_ = w.PrintfLine("250 OK")
+			case "DATA":
+				_ = w.PrintfLine("354 go ahead")
+
+				data, err := r.ReadDotBytes()
+				if err != nil {
+					return
+				}
+
+				session.data = string(data)
+				_ = w.PrintfLine("250 OK")
+			case "QUIT":
+				_ = w.PrintfLine("221 bye")
+				received <- session
+
+				return
+			default:
+				_ = w.PrintfLine("502 not implemented")
+			}
+		}
+	}()
+
+	return listener.Addr().(*net.TCPAddr).Port, received
+}
+
+func newTestMailer(t *testing.T, port int) *SMTPMailer {
+	t.Helper()
+
+	m, err := NewSMTPMailer(SMTPOptions{
+		Host:    "127.0.0.1",
+		Port:    port,
+		From:    "Moving <no-reply@example.com>",
+		Timeout: 5 * time.Second,
+	})
+	if err != nil {
+		t.Fatalf("failed to create mailer: %v", err)
+	}
+
+	return m
+}
+
+func TestSMTPMailerSend(t *testing.T) {
+	port, sessions := serveSMTP(t)
+	m := newTestMailer(t, port)
+
+	err := m.Send(context.Background(), &Message{
+		To:       []string{"Customer <customer@example.com>"},
+		FromName: "Movers\r\nBcc: victim@example.com",
+		ReplyTo:  "office@example.com",
+		Subject:  "Order #1\r\nBcc: victim@example.com",
+		Text:     "hello",
+	})
+	if err != nil {
+		t.Fatalf("send failed: %v", err)
+	}
+
+	session := <-sessions
+
+	if session.from != "MAIL FROM:<no-reply@example.com>" {
+		t.Errorf("unexpected sender %q", session.from)
+	}
+
+	if len(session.rcpt) != 1 || session.rcpt[0] != "RCPT TO:<customer@example.com>" {
+		t.Errorf("unexpected recipients %q", session.rcpt)
+	}
+
+	msg, err := mail.ReadMessage(strings.NewReader(session.data))
+	if err != nil {
+		t.Fatalf("failed to parse message: %v", err)
+	}
+
+	if bcc := msg.Header.Get("Bcc"); bcc != "" {
+		t.Errorf("injected Bcc header %q", bcc)
+	}
+
+	for header, want := range map[string]string{
+		"To":       `"Customer" <customer@example.com>`,
+		"Reply-To": "<office@example.com>",
+	} {
+		if got := msg.Header.Get(header); got != want {
+			t.Errorf("%s is %q, want %q", header, got, want)
+		}
+	}
+
+	from, err := msg.Header.AddressList("From")
+	if err != nil || len(from) != 1 || from[0].Address != "no-reply@example.com" {
+		t.Errorf("unexpected From %q", msg.Header.Get("From"))
+	}
+}
+
+func TestSMTPMailerSendRejectsInvalidAddresses(t *testing.T) {
+	for name, msg := range map[string]*Message{
+		"recipient": {To: []string{"customer@example.com\r\nBcc: victim@example.com"}, Text: "hello"},
+		"reply-to":  {To: []string{"customer@example.com"}, ReplyTo: "a@example.com\r\nBcc: victim@example.com"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// nothing listens there, an invalid message must fail before connecting
+			m := newTestMailer(t, 1)
+
+			if err := m.Send(context.Background(), msg); !errors.Is(err, errInvalidAddress) {
+				t.Fatalf("got %v, want %v", err, errInvalidAddress)
+			}
+		})
+	}
+}
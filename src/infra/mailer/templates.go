@@ -0,0 +1,105 @@
+package mailer
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"io/fs"
+	"os"
+	"strings"
+	texttemplate "text/template"
+)
+
+// Every message is described by three templates: <name>.subject.tmpl, <name>.txt.tmpl and <name>.html.tmpl.
+const (
+	subjectSuffix = ".subject.tmpl"
+	textSuffix    = ".txt.tmpl"
+	htmlSuffix    = ".html.tmpl"
+)
+
+//go:embed templates/*.tmpl
+var embedded embed.FS
+
+type Templates struct {
+	text *texttemplate.Template
+	html *htmltemplate.Template
+}
+
+// NewTemplates loads the embedded templates, files from overrideDir replace the ones with the same name.
+func NewTemplates(overrideDir string) (*Templates, error) {
+	builtin, err := fs.Sub(embedded, "templates")
+	if err != nil {
+		return nil, fmt.Errorf("failed to open embedded templates | %w", err)
+	}
+
+	t := &Templates{
+		text: texttemplate.New("mail"),
+		html: htmltemplate.New("mail"),
+	}
+
+	if err = t.parse(builtin); err != nil {
+		return nil, fmt.Errorf("failed to parse embedded templates | %w", err)
+	}
+
+	if overrideDir != "" {
+		if err = t.parse(os.DirFS(overrideDir)); err != nil {
+			return nil, fmt.Errorf("failed to parse templates from %s | %w", overrideDir, err)
+		}
+	}
+
+	return t, nil
+}
+
+func (t *Templates) parse(fsys fs.FS) error {
+	for _, suffix := range []string{subjectSuffix, textSuffix} {
+		matches, err := fs.Glob(fsys, "*"+suffix)
+		if err != nil {
+			return err
+		}
+
+		if len(matches) == 0 {
+			continue
+		}
+
+		if _, err = t.text.ParseFS(fsys, matches...); err != nil {
+			return err
+		}
+	}
+
+	matches, err := fs.Glob(fsys, "*"+htmlSuffix)
+	if err != nil {
+		return err
+	}
+
+	if len(matches) == 0 {
+		return nil
+	}
+
+	_, err = t.html.ParseFS(fsys, matches...)
+
+	return err
+}
+
+// Render builds a message without recipients.
+func (t *Templates) Render(name string, data any) (*Message, error) {
+	var subject, text, html bytes.Buffer
+
+	if err := t.text.ExecuteTemplate(&subject, name+subjectSuffix, data); err != nil {
+		return nil, fmt.Errorf("failed to render %s subject | %w", name, err)
+	}
+
+	if err := t.text.ExecuteTemplate(&text, name+textSuffix, data); err != nil {
+		return nil, fmt.Errorf("failed to render %s text | %w", name, err)
+	}
+
+	if err := t.html.ExecuteTemplate(&html, name+htmlSuffix, data); err != nil {
+		return nil, fmt.Errorf("failed to render %s html | %w", name, err)
+	}
+
+	return &Message{
+		Subject: strings.TrimSpace(subject.String()),
+		Text:    text.String(),
+		HTML:    html.String(),
+	}, nil
+}
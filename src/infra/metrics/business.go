@@ -0,0 +1,164 @@
+package metrics
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+)
+
+const (
+	resultSuccess = "success"
+	resultFailure = "failure"
+
+	outboxResultDelivered = "delivered"
+)
+
+// Business holds domain level series: created orders, status transitions,
+// telegram notifications, currently open orders, outbox and webhook deliveries.
+// When metrics are disabled the collectors still work but are not registered.
+type Business struct {
+	ordersCreated         *prometheus.CounterVec
+	statusTransitions     *prometheus.CounterVec
+	telegramNotifications *prometheus.CounterVec
+	openOrders            *prometheus.GaugeVec
+	outboxEvents          *prometheus.CounterVec
+	outboxLag             *prometheus.HistogramVec
+	outboxPending         *prometheus.GaugeVec
+	webhookDeliveries     *prometheus.CounterVec
+}
+
+func NewBusiness(enabled bool, serviceName string) *Business {
+	serviceName = strings.ReplaceAll(serviceName, "-", "_")
+
+	b := &Business{
+		ordersCreated: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: serviceName,
+			Subsystem: "orders",
+			Name:      "created_count",
+			Help:      "Created orders count by property size, lead source and api protocol.",
+		}, []string{"property_size", "source", "protocol"}),
+		statusTransitions: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: serviceName,
+			Subsystem: "orders",
+			Name:      "status_transitions_count",
+			Help:      "Order status transitions count by previous and new status.",
+		}, []string{"from", "to"}),
+		telegramNotifications: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: serviceName,
+			Subsystem: "telegram",
+			Name:      "notifications_count",
+			Help:      "Telegram notifications count by result.",
+		}, []string{"result"}),
+		openOrders: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: serviceName,
+			Subsystem: "orders",
+			Name:      "open",
+			Help:      "Open orders count by tenant and status.",
+		}, []string{"tenant", "status"}),
+		outboxEvents: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: serviceName,
+			Subsystem: "outbox",
+			Name:      "events_count",
+			Help:      "Outbox delivery attempts count by destination and result.",
+		}, []string{"destination", "result"}),
+		outboxLag: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: serviceName,
+			Subsystem: "outbox",
+			Name:      "delivery_lag_seconds",
+			Help:      "Time from event creation to its delivery by destination.",
+			Buckets:   []float64{0.1, 0.5, 1, 5, 15, 60, 300, 900, 3600, 6 * 3600},
+		}, []string{"destination"}),
+		outboxPending: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: serviceName,
+			Subsystem: "outbox",
+			Name:      "pending",
+			Help:      "Events waiting for delivery by destination.",
+		}, []string{"destination"}),
+		webhookDeliveries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: serviceName,
+			Subsystem: "webhooks",
+			Name:      "deliveries_count",
+			Help:      "Webhook delivery attempts count by result.",
+		}, []string{"result"}),
+	}
+
+	if enabled {
+		prometheus.MustRegister(
+			b.ordersCreated, b.statusTransitions, b.telegramNotifications, b.openOrders,
+			b.outboxEvents, b.outboxLag, b.outboxPending, b.webhookDeliveries,
+		)
+	}
+
+	return b
+}
+
+// OrderCreated source is the lead channel of the order, protocol is http for the gateway and grpc otherwise.
+func (b *Business) OrderCreated(propertySize, source, protocol string) {
+	b.ordersCreated.WithLabelValues(propertySize, source, protocol).Inc()
+}
+
+func (b *Business) OrderStatusChanged(from, to string) {
+	b.statusTransitions.WithLabelValues(from, to).Inc()
+}
+
+func (b *Business) TelegramNotification(err error) {
+	if err != nil {
+		b.telegramNotifications.WithLabelValues(resultFailure).Inc()
+		return
+	}
+
+	b.telegramNotifications.WithLabelValues(resultSuccess).Inc()
+}
+
+// OutboxEvent lag is observed for delivered events only.
+func (b *Business) OutboxEvent(destination, result string, lag time.Duration) {
+	b.outboxEvents.WithLabelValues(destination, result).Inc()
+
+	if result == outboxResultDelivered {
+		b.outboxLag.WithLabelValues(destination).Observe(lag.Seconds())
+	}
+}
+
+func (b *Business) OutboxPending(counts map[string]uint64) {
+	for destination, count := range counts {
+		b.outboxPending.WithLabelValues(destination).Set(float64(count))
+	}
+}
+
+func (b *Business) WebhookDelivery(result string) {
+	b.webhookDeliveries.WithLabelValues(result).Inc()
+}
+
+type openOrdersCounter interface {
+	OpenOrdersByStatus(ctx context.Context) (map[string]map[string]uint64, error)
+}
+
+// RefreshOpenOrders periodically reloads open orders gauges until ctx is done.
+func (b *Business) RefreshOpenOrders(
+	ctx context.Context, logger *zap.Logger, counter openOrdersCounter, interval time.Duration,
+) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		counts, err := counter.OpenOrdersByStatus(ctx)
+		if err != nil && ctx.Err() == nil {
+			logger.Error("failed to refresh open orders metrics", zap.Error(err))
+		}
+
+		for tenant, statuses := range counts {
+			for status, count := range statuses {
+				b.openOrders.WithLabelValues(tenant, status).Set(float64(count))
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
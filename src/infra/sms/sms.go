@@ -0,0 +1,219 @@
+package sms
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1" //nolint:gosec // twilio signs callbacks with HMAC-SHA1
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Provider statuses are reduced to the ones stored in moving.sms_messages.
+const (
+	StatusQueued      = "queued"
+	StatusSent        = "sent"
+	StatusDelivered   = "delivered"
+	StatusUndelivered = "undelivered"
+	StatusFailed      = "failed"
+	// StatusRejected and StatusUnconfirmed are final failures, the message is not sent again.
+	StatusRejected    = "rejected"
+	StatusUnconfirmed = "unconfirmed"
+)
+
+var (
+	// ErrRejected the provider refused the message, sending it again gets the same answer.
+	ErrRejected = errors.New("sms rejected by provider")
+	// ErrUnconfirmed the provider did not answer clearly, the message may have been sent.
+	ErrUnconfirmed = errors.New("sms not confirmed by provider")
+)
+
+type Result struct {
+	ProviderID string
+	Status     string
+}
+
+// SMSSender errors wrap ErrRejected or ErrUnconfirmed when sending again would not help or may send twice,
+// other errors are worth a retry.
+type SMSSender interface {
+	Send(ctx context.Context, to, body string) (*Result, error)
+}
+
+type TwilioOptions struct {
+	BaseURL    string
+	AccountSID string
+	AuthToken  string
+	From       string
+	// StatusCallbackURL is called by twilio on every delivery status change, optional.
+	StatusCallbackURL string
+	Timeout           time.Duration
+}
+
+// TwilioSender sends messages through the twilio compatible messages api.
+type TwilioSender struct {
+	client *http.Client
+	opts   TwilioOptions
+}
+
+func NewTwilioSender(opts TwilioOptions) *TwilioSender {
+	return &TwilioSender{client: &http.Client{Timeout: opts.Timeout}, opts: opts}
+}
+
+func (s *TwilioSender) Send(ctx context.Context, to, body string) (*Result, error) {
+	form := url.Values{}
+	form.Set("To", to)
+	form.Set("From", s.opts.From)
+	form.Set("Body", body)
+
+	if s.opts.StatusCallbackURL != "" {
+		form.Set("StatusCallback", s.opts.StatusCallbackURL)
+	}
+
+	endpoint := fmt.Sprintf(
+		"%s/2010-04-01/Accounts/%s/Messages.json", strings.TrimSuffix(s.opts.BaseURL, "/"), s.opts.AccountSID,
+	)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build sms request | %w", err)
+	}
+
+	req.SetBasicAuth(s.opts.AccountSID, s.opts.AuthToken)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		// the request may have reached the provider before the timeout
+		var netErr net.Error
+		if errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout()) {
+			return nil, fmt.Errorf("%w | %w", ErrUnconfirmed, err)
+		}
+
+		return nil, fmt.Errorf("failed to send sms | %w", err)
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		SID     string `json:"sid"`
+		Status  string `json:"status"`
+		Message string `json:"message"`
+	}
+	decodeErr := json.NewDecoder(resp.Body).Decode(&result)
+
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError:
+		return nil, fmt.Errorf("sms provider responded with status %d: %s", resp.StatusCode, result.Message)
+	case resp.StatusCode >= http.StatusBadRequest:
+		return nil, fmt.Errorf("%w, status %d: %s", ErrRejected, resp.StatusCode, result.Message)
+	case resp.StatusCode >= http.StatusMultipleChoices:
+		return nil, fmt.Errorf("sms provider responded with status %d", resp.StatusCode)
+	case decodeErr != nil:
+		// the message is accepted, but its id is unknown
+		return nil, fmt.Errorf("%w, failed to decode response | %w", ErrUnconfirmed, decodeErr)
+	}
+
+	return &Result{ProviderID: result.SID, Status: NewStatus(result.Status)}, nil
+}
+
+// NewStatus maps provider statuses, everything not final yet is queued.
+func NewStatus(providerStatus string) string {
+	switch providerStatus {
+	case "sent":
+		return StatusSent
+	case "delivered", "read":
+		return StatusDelivered
+	case "undelivered":
+		return StatusUndelivered
+	case "failed", "canceled":
+		return StatusFailed
+	default:
+		return StatusQueued
+	}
+}
+
+type statusRecorder interface {
+	UpdateStatus(ctx context.Context, providerID, status string, errorCode *string) error
+}
+
+// StatusCallbackHandler records delivery statuses reported by twilio.
+// Requests are authenticated by the X-Twilio-Signature header.
+func (s *TwilioSender) StatusCallbackHandler(logger *zap.Logger, recorder statusRecorder) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		if !s.validSignature(r.Header.Get("X-Twilio-Signature"), r.PostForm) {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		var errorCode *string
+		if code := r.PostForm.Get("ErrorCode"); code != "" {
+			errorCode = &code
+		}
+
+		if err := recorder.UpdateStatus(
+			r.Context(), r.PostForm.Get("MessageSid"), NewStatus(r.PostForm.Get("MessageStatus")), errorCode,
+		); err != nil {
+			logger.Error("failed to record sms status", zap.Error(err))
+			w.WriteHeader(http.StatusInternalServerError)
+
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// validSignature is HMAC-SHA1 of the callback url followed by sorted form keys and values.
+func (s *TwilioSender) validSignature(signature string, form url.Values) bool {
+	var payload strings.Builder
+	payload.WriteString(s.opts.StatusCallbackURL)
+
+	for _, key := range slices.Sorted(maps.Keys(form)) {
+		for _, value := range form[key] {
+			payload.WriteString(key + value)
+		}
+	}
+
+	mac := hmac.New(sha1.New, []byte(s.opts.AuthToken))
+	mac.Write([]byte(payload.String()))
+
+	expected := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
+
+// FakeSender logs messages instead of sending them, it is meant for local development.
+type FakeSender struct {
+	logger *zap.Logger
+}
+
+func NewFakeSender(logger *zap.Logger) *FakeSender {
+	return &FakeSender{logger: logger}
+}
+
+func (s *FakeSender) Send(_ context.Context, to, body string) (*Result, error) {
+	random := make([]byte, 16)
+	_, _ = rand.Read(random)
+
+	id := "fake-" + hex.EncodeToString(random)
+
+	s.logger.Info("fake sms sent", zap.String("to", to), zap.String("body", body), zap.String("provider_id", id))
+
+	return &Result{ProviderID: id, Status: StatusDelivered}, nil
+}
@@ -0,0 +1,93 @@
+package sms
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"io/fs"
+	"os"
+	"strings"
+	"text/template"
+)
+
+// Every message kind is rendered from <kind>.tmpl.
+const templateSuffix = ".tmpl"
+
+//go:embed templates/*.tmpl
+var embedded embed.FS
+
+type Templates struct {
+	tmpl *template.Template
+}
+
+// NewTemplates loads the embedded templates, files from overrideDir replace the ones with the same name.
+func NewTemplates(overrideDir string) (*Templates, error) {
+	t := &Templates{tmpl: template.New("sms")}
+
+	builtin, err := fs.Sub(embedded, "templates")
+	if err != nil {
+		return nil, fmt.Errorf("failed to open embedded templates | %w", err)
+	}
+
+	if err = t.parse(builtin); err != nil {
+		return nil, fmt.Errorf("failed to parse embedded templates | %w", err)
+	}
+
+	if overrideDir != "" {
+		if err = t.parse(os.DirFS(overrideDir)); err != nil {
+			return nil, fmt.Errorf("failed to parse templates from %s | %w", overrideDir, err)
+		}
+	}
+
+	return t, nil
+}
+
+func (t *Templates) parse(fsys fs.FS) error {
+	matches, err := fs.Glob(fsys, "*"+templateSuffix)
+	if err != nil || len(matches) == 0 {
+		return err
+	}
+
+	_, err = t.tmpl.ParseFS(fsys, matches...)
+
+	return err
+}
+
+func (t *Templates) Render(kind string, data any) (string, error) {
+	var buf bytes.Buffer
+
+	if err := t.tmpl.ExecuteTemplate(&buf, kind+templateSuffix, data); err != nil {
+		return "", fmt.Errorf("failed to render %s | %w", kind, err)
+	}
+
+	return strings.TrimSpace(buf.String()), nil
+}
+
+// NormalizePhone converts a phone typed by a customer to E.164,
+// numbers without a country code get defaultCountryCode.
+func NormalizePhone(phone, defaultCountryCode string) (string, bool) {
+	var digits strings.Builder
+
+	for _, r := range phone {
+		if r >= '0' && r <= '9' {
+			digits.WriteRune(r)
+		}
+	}
+
+	number := digits.String()
+
+	switch {
+	case strings.HasPrefix(strings.TrimSpace(phone), "+"):
+	case strings.HasPrefix(number, "00"):
+		number = strings.TrimPrefix(number, "00")
+	case len(number) == 10:
+		number = defaultCountryCode + number
+	}
+
+	// E.164 numbers are up to 15 digits, the shortest real ones are 8
+	if len(number) < 8 || len(number) > 15 {
+		return "", false
+	}
+
+	return "+" + number, true
+}
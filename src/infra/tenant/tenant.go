@@ -0,0 +1,95 @@
+package tenant
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+var ErrNoTenant = errors.New("no tenant in context")
+
+// Tenant is a brand served by the service, its orders, reviews and telegram chats are kept apart.
+type Tenant struct {
+	ID       uint64
+	Slug     string
+	Name     string
+	Hosts    []string
+	Branding Branding
+	Pricing  Pricing
+}
+
+// Branding is how customer notifications of the tenant are signed, empty fields are left out.
+type Branding struct {
+	Name    string
+	Phone   string
+	Email   string
+	SiteURL string
+}
+
+// ReviewURL links the customer to the review form of the order on the site, it is empty without a site.
+func (b Branding) ReviewURL(orderID uint64, secret string) string {
+	if b.SiteURL == "" || secret == "" {
+		return ""
+	}
+
+	query := url.Values{"order": {strconv.FormatUint(orderID, 10)}, "secret": {secret}}
+
+	return strings.TrimRight(b.SiteURL, "/") + "/review?" + query.Encode()
+}
+
+// Pricing estimates are keyed by property size name, e.g. studio or 2_bedrooms.
+type Pricing struct {
+	Currency  string                `json:"currency"`
+	Estimates map[string]PriceRange `json:"estimates"`
+}
+
+type PriceRange struct {
+	From uint64 `json:"from"`
+	To   uint64 `json:"to"`
+}
+
+// Estimate returns the price range of the property size as text, e.g. "300-500 USD".
+func (p Pricing) Estimate(propertySize string) (string, bool) {
+	estimate, ok := p.Estimates[propertySize]
+	if !ok {
+		return "", false
+	}
+
+	text := fmt.Sprint(estimate.From)
+	if estimate.To > estimate.From {
+		text += fmt.Sprintf("-%d", estimate.To)
+	}
+
+	if p.Currency != "" {
+		text += " " + p.Currency
+	}
+
+	return text, true
+}
+
+type contextKey struct{}
+
+func WithTenant(ctx context.Context, tenant *Tenant) context.Context {
+	return context.WithValue(ctx, contextKey{}, tenant)
+}
+
+// FromContext returns the tenant of the call, it is resolved by the tenant interceptor
+// and set by background jobs before touching tenant data.
+func FromContext(ctx context.Context) (*Tenant, bool) {
+	tenant, ok := ctx.Value(contextKey{}).(*Tenant)
+
+	return tenant, ok && tenant != nil
+}
+
+// ID returns the id of the tenant of the call, data of an unknown tenant is never read or written.
+func ID(ctx context.Context) (uint64, error) {
+	tenant, ok := FromContext(ctx)
+	if !ok {
+		return 0, ErrNoTenant
+	}
+
+	return tenant.ID, nil
+}
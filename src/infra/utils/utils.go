@@ -1,6 +1,23 @@
 package utils
 
-import "reflect"
+import (
+	"context"
+	"math/rand/v2"
+	"net"
+	"reflect"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	ProtocolHTTP = "http"
+	ProtocolGRPC = "grpc"
+
+	forwardedForKey = "x-forwarded-for"
+)
 
 func PtrIfNotZero[T any](v T) *T {
 	var zero T
@@ -17,3 +34,73 @@ func ToMap[T comparable](s []T) map[T]struct{} {
 	}
 	return m
 }
+
+// Backoff grows exponentially with the attempt number up to limit and has a jitter,
+// so calls failed together are not retried together.
+func Backoff(base, limit time.Duration, attempt int) time.Duration {
+	delay := base
+	for i := 1; i < attempt && delay < limit; i++ {
+		delay *= 2
+	}
+
+	delay = min(delay, limit)
+
+	return delay + rand.N(delay/5+1)
+}
+
+// RequestProtocol reports whether the incoming call came through the http gateway or directly over grpc.
+// It returns an empty string when the context has no incoming metadata.
+func RequestProtocol(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	if len(md.Get("grpcgateway-user-agent")) > 0 {
+		return ProtocolHTTP
+	}
+
+	return ProtocolGRPC
+}
+
+// ClientIP walks X-Forwarded-For from the right skipping trustedHops entries appended by our proxies,
+// entries on the left are sent by the client and can be forged. The http gateway appends its own peer,
+// a direct grpc call gets the peer appended here, so trustedHops counts only the proxies in front of us.
+// It falls back to the peer address when the request passed fewer hops than trusted.
+func ClientIP(ctx context.Context, trustedHops int) string {
+	var hops []string
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, value := range md.Get(forwardedForKey) {
+			for _, ip := range strings.Split(value, ",") {
+				if ip = strings.TrimSpace(ip); ip != "" {
+					hops = append(hops, ip)
+				}
+			}
+		}
+	}
+
+	peerIP := peerAddress(ctx)
+	if peerIP != "" && RequestProtocol(ctx) != ProtocolHTTP {
+		hops = append(hops, peerIP)
+	}
+
+	if i := len(hops) - 1 - trustedHops; i >= 0 && i < len(hops) {
+		return hops[i]
+	}
+
+	return peerIP
+}
+
+func peerAddress(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	if host, _, err := net.SplitHostPort(p.Addr.String()); err == nil {
+		return host
+	}
+
+	return p.Addr.String()
+}
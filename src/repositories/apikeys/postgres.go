@@ -0,0 +1,198 @@
+package apikeys
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var ErrNotFound = errors.New("not found")
+
+const keyColumns = `id, tenant_id, name, prefix, scopes, expires_at, last_used_at, created_by, created_at, revoked_at`
+
+type Postgres struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgres(pool *pgxpool.Pool) *Postgres {
+	return &Postgres{pool: pool}
+}
+
+func (p *Postgres) CreateKey(ctx context.Context, req *CreateKeyRequest) (*Key, error) {
+	key, err := scanKey(p.pool.QueryRow(ctx, `
+insert into moving.api_keys (tenant_id, name, prefix, key_hash, scopes, expires_at, created_by)
+values ($1, $2, $3, $4, $5, $6, $7)
+returning `+keyColumns, req.TenantID, req.Name, req.Prefix, req.KeyHash, req.Scopes, req.ExpiresAt, req.CreatedBy))
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert api key | %w", err)
+	}
+
+	return key, nil
+}
+
+func (p *Postgres) Keys(ctx context.Context, tenantID uint64) ([]*Key, error) {
+	rows, err := p.pool.Query(ctx, `
+select `+keyColumns+`
+from moving.api_keys
+where tenant_id = $1
+order by id
+`, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query api keys | %w", err)
+	}
+	defer rows.Close()
+
+	var keys []*Key
+
+	for rows.Next() {
+		key, scanErr := scanKey(rows)
+		if scanErr != nil {
+			return nil, fmt.Errorf("failed scan api key | %w", scanErr)
+		}
+
+		keys = append(keys, key)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed get api keys | %w", err)
+	}
+
+	if len(keys) == 0 {
+		return nil, ErrNotFound
+	}
+
+	return keys, nil
+}
+
+// ActiveKey returns the key of the tenant unless it is revoked or expired.
+func (p *Postgres) ActiveKey(ctx context.Context, tenantID, id uint64) (*Key, error) {
+	key, err := scanKey(p.pool.QueryRow(ctx, `
+select `+keyColumns+`
+from moving.api_keys
+where id = $1 and tenant_id = $2 and revoked_at is null and (expires_at is null or expires_at > now())
+`, id, tenantID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get api key | %w", err)
+	}
+
+	return key, nil
+}
+
+func (p *Postgres) RevokeKey(ctx context.Context, tenantID, id uint64) error {
+	tag, err := p.pool.Exec(ctx, `
+update moving.api_keys
+set revoked_at = now()
+where id = $1 and tenant_id = $2 and revoked_at is null
+`, id, tenantID)
+	if err != nil {
+		return fmt.Errorf("failed to revoke api key | %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// RotateKey creates a key with the name and scopes of the active key of req.TenantID, which expires
+// at oldExpiresAt unless it expires earlier.
+func (p *Postgres) RotateKey(ctx context.Context, id uint64, req *CreateKeyRequest, oldExpiresAt time.Time) (*Key, error) {
+	tx, err := p.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin tx | %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	var (
+		name   string
+		scopes []string
+	)
+	if err = tx.QueryRow(ctx, `
+update moving.api_keys
+set expires_at = least(coalesce(expires_at, $2), $2)
+where id = $1 and tenant_id = $3 and revoked_at is null and (expires_at is null or expires_at > now())
+returning name, scopes
+`, id, oldExpiresAt, req.TenantID).Scan(&name, &scopes); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+
+		return nil, fmt.Errorf("failed to expire api key | %w", err)
+	}
+
+	key, err := scanKey(tx.QueryRow(ctx, `
+insert into moving.api_keys (tenant_id, name, prefix, key_hash, scopes, expires_at, created_by)
+values ($1, $2, $3, $4, $5, $6, $7)
+returning `+keyColumns, req.TenantID, name, req.Prefix, req.KeyHash, scopes, req.ExpiresAt, req.CreatedBy))
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert api key | %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit tx | %w", err)
+	}
+
+	return key, nil
+}
+
+// UseKey returns the active key with the hash and marks it used.
+func (p *Postgres) UseKey(ctx context.Context, keyHash string) (*Key, error) {
+	key, err := scanKey(p.pool.QueryRow(ctx, `
+update moving.api_keys
+set last_used_at = now()
+where key_hash = $1 and revoked_at is null and (expires_at is null or expires_at > now())
+returning `+keyColumns, keyHash))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+
+		return nil, fmt.Errorf("failed to use api key | %w", err)
+	}
+
+	return key, nil
+}
+
+func scanKey(row pgx.Row) (*Key, error) {
+	var key Key
+	if err := row.Scan(
+		&key.ID, &key.TenantID, &key.Name, &key.Prefix, &key.Scopes, &key.ExpiresAt, &key.LastUsedAt,
+		&key.CreatedBy, &key.CreatedAt, &key.RevokedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return &key, nil
+}
+
+type CreateKeyRequest struct {
+	TenantID  uint64
+	Name      string
+	Prefix    string
+	KeyHash   string
+	Scopes    []string
+	ExpiresAt *time.Time
+	CreatedBy *uint64
+}
+
+type Key struct {
+	ID         uint64
+	TenantID   uint64
+	Name       string
+	Prefix     string
+	Scopes     []string
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	CreatedBy  *uint64
+	CreatedAt  time.Time
+	RevokedAt  *time.Time
+}
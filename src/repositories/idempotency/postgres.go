@@ -0,0 +1,146 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Record is a stored result for a key, Response is nil while the request is in progress.
+type Record struct {
+	RequestHash  string
+	ResponseType string
+	Response     []byte
+}
+
+// Postgres keeps idempotency keys shared by all replicas. A reservation without response is held
+// for lease and extended while the request runs, once the lease expires the request was lost
+// with its replica and a retry takes the key over.
+type Postgres struct {
+	pool   *pgxpool.Pool
+	window time.Duration
+	lease  time.Duration
+
+	mutex     sync.Mutex
+	lastPurge time.Time
+}
+
+func NewPostgres(pool *pgxpool.Pool, window, lease time.Duration) *Postgres {
+	return &Postgres{pool: pool, window: window, lease: lease}
+}
+
+func (p *Postgres) Reserve(
+	ctx context.Context, key, method, requestHash string,
+) (*Record, bool, error) {
+	now := time.Now().UTC()
+
+	if err := p.purge(ctx, now); err != nil {
+		return nil, false, err
+	}
+
+	query := `
+insert into moving.idempotency_keys (key, method, request_hash, created_at, locked_until)
+values ($1, $2, $3, $4, $5)
+on conflict (key, method) do update set
+	request_hash = excluded.request_hash,
+	response_type = null,
+	response = null,
+	created_at = excluded.created_at,
+	locked_until = excluded.locked_until
+where moving.idempotency_keys.created_at < $6
+	or (moving.idempotency_keys.response is null and moving.idempotency_keys.locked_until < $4)
+`
+
+	tag, err := p.pool.Exec(ctx, query, key, method, requestHash, now, now.Add(p.lease), now.Add(-p.window))
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to reserve idempotency key | %w", err)
+	}
+
+	if tag.RowsAffected() == 1 {
+		return nil, true, nil
+	}
+
+	var (
+		stored       Record
+		responseType *string
+	)
+
+	if err = p.pool.QueryRow(ctx, `
+select request_hash, response_type, response
+from moving.idempotency_keys
+where key = $1 and method = $2
+`, key, method).Scan(&stored.RequestHash, &responseType, &stored.Response); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			// released between the queries, behave like the key is still taken
+			return &Record{RequestHash: requestHash}, false, nil
+		}
+
+		return nil, false, fmt.Errorf("failed to get idempotency key | %w", err)
+	}
+
+	if responseType != nil {
+		stored.ResponseType = *responseType
+	}
+
+	return &stored, false, nil
+}
+
+// Extend keeps the reservation of a running request for another lease.
+func (p *Postgres) Extend(ctx context.Context, key, method string) error {
+	if _, err := p.pool.Exec(ctx, `
+update moving.idempotency_keys
+set locked_until = $3
+where key = $1 and method = $2 and response is null
+`, key, method, time.Now().UTC().Add(p.lease)); err != nil {
+		return fmt.Errorf("failed to extend idempotency key | %w", err)
+	}
+
+	return nil
+}
+
+func (p *Postgres) Complete(ctx context.Context, key, method, responseType string, response []byte) error {
+	if _, err := p.pool.Exec(ctx, `
+update moving.idempotency_keys
+set response_type = $3, response = $4
+where key = $1 and method = $2
+`, key, method, responseType, response); err != nil {
+		return fmt.Errorf("failed to complete idempotency key | %w", err)
+	}
+
+	return nil
+}
+
+func (p *Postgres) Release(ctx context.Context, key, method string) error {
+	if _, err := p.pool.Exec(ctx, `
+delete from moving.idempotency_keys
+where key = $1 and method = $2 and response is null
+`, key, method); err != nil {
+		return fmt.Errorf("failed to release idempotency key | %w", err)
+	}
+
+	return nil
+}
+
+// purge drops expired keys at most once per window.
+func (p *Postgres) purge(ctx context.Context, now time.Time) error {
+	p.mutex.Lock()
+	if now.Sub(p.lastPurge) < p.window {
+		p.mutex.Unlock()
+		return nil
+	}
+	p.lastPurge = now
+	p.mutex.Unlock()
+
+	if _, err := p.pool.Exec(
+		ctx, `delete from moving.idempotency_keys where created_at < $1`, now.Add(-p.window),
+	); err != nil {
+		return fmt.Errorf("failed to purge idempotency keys | %w", err)
+	}
+
+	return nil
+}
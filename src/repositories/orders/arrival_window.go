@@ -0,0 +1,37 @@
+package orders
+
+// ArrivalWindow is when the crew arrives on the move date, it is set by dispatchers.
+type ArrivalWindow int8
+
+const (
+	ArrivalWindowUnknown ArrivalWindow = iota
+	ArrivalWindowMorning
+	ArrivalWindowMidday
+	ArrivalWindowAfternoon
+)
+
+func (w ArrivalWindow) String() string {
+	switch w {
+	case ArrivalWindowMorning:
+		return "morning"
+	case ArrivalWindowMidday:
+		return "midday"
+	case ArrivalWindowAfternoon:
+		return "afternoon"
+	default:
+		return "unknown"
+	}
+}
+
+func NewArrivalWindow(s string) ArrivalWindow {
+	switch s {
+	case "morning":
+		return ArrivalWindowMorning
+	case "midday":
+		return ArrivalWindowMidday
+	case "afternoon":
+		return ArrivalWindowAfternoon
+	default:
+		return ArrivalWindowUnknown
+	}
+}
@@ -0,0 +1,56 @@
+package orders
+
+type LeadSource int8
+
+const (
+	LeadSourceUnknown LeadSource = iota
+	LeadSourceWebsite
+	LeadSourceYelp
+	LeadSourceGoogleAds
+	LeadSourceFacebookAds
+	LeadSourceReferral
+	LeadSourcePhone
+	LeadSourceOther
+)
+
+func (s LeadSource) String() string {
+	switch s {
+	case LeadSourceWebsite:
+		return "website"
+	case LeadSourceYelp:
+		return "yelp"
+	case LeadSourceGoogleAds:
+		return "google_ads"
+	case LeadSourceFacebookAds:
+		return "facebook_ads"
+	case LeadSourceReferral:
+		return "referral"
+	case LeadSourcePhone:
+		return "phone"
+	case LeadSourceOther:
+		return "other"
+	default:
+		return "unknown"
+	}
+}
+
+func NewLeadSource(s string) LeadSource {
+	switch s {
+	case "website":
+		return LeadSourceWebsite
+	case "yelp":
+		return LeadSourceYelp
+	case "google_ads":
+		return LeadSourceGoogleAds
+	case "facebook_ads":
+		return LeadSourceFacebookAds
+	case "referral":
+		return LeadSourceReferral
+	case "phone":
+		return LeadSourcePhone
+	case "other":
+		return LeadSourceOther
+	default:
+		return LeadSourceUnknown
+	}
+}
@@ -0,0 +1,64 @@
+package orders
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// ordersChannel is the postgres notification channel of committed order changes.
+const ordersChannel = "moving_orders"
+
+// OrderNotification is kept small, postgres limits notification payloads to 8000 bytes.
+type OrderNotification struct {
+	Type     string `json:"type"`
+	ID       uint64 `json:"id"`
+	TenantID uint64 `json:"tenant_id"`
+}
+
+// notify is delivered to listeners of every replica when the order transaction commits.
+func notify(ctx context.Context, tx pgx.Tx, eventType string, order *Order) error {
+	data, err := json.Marshal(OrderNotification{Type: eventType, ID: order.ID, TenantID: order.TenantID})
+	if err != nil {
+		return fmt.Errorf("failed to marshal order notification | %w", err)
+	}
+
+	if _, err = tx.Exec(ctx, `select pg_notify($1, $2)`, ordersChannel, string(data)); err != nil {
+		return fmt.Errorf("failed to notify order change | %w", err)
+	}
+
+	return nil
+}
+
+// ListenOrders passes order changes to handle until ctx is done or the connection breaks.
+// Changes committed while nobody listens are not replayed.
+func (p *Postgres) ListenOrders(ctx context.Context, handle func(notification *OrderNotification)) error {
+	conn, err := p.pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to acquire connection | %w", err)
+	}
+
+	// the listening connection is not returned to the pool, other queries must not get its notifications
+	pgConn := conn.Hijack()
+	defer func() { _ = pgConn.Close(context.Background()) }()
+
+	if _, err = pgConn.Exec(ctx, "listen "+pgx.Identifier{ordersChannel}.Sanitize()); err != nil {
+		return fmt.Errorf("failed to listen order changes | %w", err)
+	}
+
+	for {
+		notification, err := pgConn.WaitForNotification(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to wait for order changes | %w", err)
+		}
+
+		var orderNotification OrderNotification
+		if err = json.Unmarshal([]byte(notification.Payload), &orderNotification); err != nil {
+			return fmt.Errorf("failed to decode order notification | %w", err)
+		}
+
+		handle(&orderNotification)
+	}
+}
@@ -0,0 +1,88 @@
+package orders
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	EventOrderCreated = "order.created"
+	EventOrderUpdated = "order.updated"
+	// EventOrderStatusChanged is written next to EventOrderUpdated when the status differs.
+	EventOrderStatusChanged = "order.status_changed"
+)
+
+// OrderEvent is the outbox payload of order events, enums are stored by name.
+type OrderEvent struct {
+	ID             uint64    `json:"id"`
+	TenantID       uint64    `json:"tenant_id"`
+	Status         string    `json:"status"`
+	PreviousStatus string    `json:"previous_status,omitempty"`
+	PropertySize   string    `json:"property_size"`
+	Source         string    `json:"source"`
+	MoveDate       time.Time `json:"move_date"`
+	Name           string    `json:"name"`
+	Email          *string   `json:"email,omitempty"`
+	Phone          string    `json:"phone"`
+	MoveFrom       string    `json:"move_from"`
+	MoveTo         string    `json:"move_to"`
+	AdditionalInfo *string   `json:"additional_info,omitempty"`
+	SpamReason     *string   `json:"spam_reason,omitempty"`
+	ArrivalWindow  string    `json:"arrival_window"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// enqueue writes the event for every outbox destination inside the order transaction,
+// so the event exists if and only if the order change was committed.
+func (p *Postgres) enqueue(
+	ctx context.Context, tx pgx.Tx, eventType string, order *Order, previousStatus *OrderStatus,
+) error {
+	if len(p.outboxDestinations) == 0 {
+		return nil
+	}
+
+	payload := OrderEvent{
+		ID:             order.ID,
+		TenantID:       order.TenantID,
+		Status:         order.OrderStatus.String(),
+		PropertySize:   order.PropertySize.String(),
+		Source:         order.Source.String(),
+		MoveDate:       order.MoveDate,
+		Name:           order.Name,
+		Email:          order.Email,
+		Phone:          order.Phone,
+		MoveFrom:       order.MoveFrom,
+		MoveTo:         order.MoveTo,
+		AdditionalInfo: order.AdditionalInfo,
+		SpamReason:     order.SpamReason,
+		ArrivalWindow:  order.ArrivalWindow.String(),
+		CreatedAt:      order.CreatedAt,
+		UpdatedAt:      order.UpdatedAt,
+	}
+
+	if previousStatus != nil {
+		payload.PreviousStatus = previousStatus.String()
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal order event | %w", err)
+	}
+
+	query := `
+insert into moving.outbox (destination, event_type, aggregate_id, payload)
+select destination, $2, $3, $4
+from unnest($1::text[]) as destination
+`
+
+	if _, err = tx.Exec(ctx, query, p.outboxDestinations, eventType, order.ID, data); err != nil {
+		return fmt.Errorf("failed to insert outbox event | %w", err)
+	}
+
+	return nil
+}
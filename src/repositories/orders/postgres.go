@@ -2,9 +2,9 @@ package orders
 
 import (
 	"context"
-	"database/sql"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/Masterminds/squirrel"
@@ -17,84 +17,98 @@ var ErrNotFound = errors.New("not found")
 
 type Postgres struct {
 	pool *pgxpool.Pool
+
+	outboxDestinations []string
+}
+
+// NewPostgres every order change is written to the outbox once per destination.
+func NewPostgres(pool *pgxpool.Pool, outboxDestinations ...string) *Postgres {
+	return &Postgres{pool: pool, outboxDestinations: outboxDestinations}
 }
 
-func NewPostgres(pool *pgxpool.Pool) *Postgres {
-	return &Postgres{pool: pool}
+// orderColumns are selected by every query returning a full order, scanOrder relies on their order.
+var orderColumns = []string{
+	"id", "tenant_id", "name", "email", "phone", "move_date", "move_from", "move_to",
+	"property_size", "status", "additional_info", "review_secret::text",
+	"source", "utm_source", "utm_medium", "utm_campaign", "referrer", "landing_page",
+	"spam_reason", "arrival_window", "created_at", "updated_at", "updated_by",
 }
 
 func (p *Postgres) CreateOrder(ctx context.Context, req *CreateOrderRequest) (*Order, error) {
 	query := `
 insert into moving.orders (
 	name, email, phone, move_date, move_from, move_to,
-	property_size, status, additional_info, created_at, updated_at
-) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, now(), now())
-returning id, name, email, phone, move_date, move_from, move_to,
-	property_size, status, additional_info, created_at, updated_at;
-`
+	property_size, status, additional_info,
+	source, utm_source, utm_medium, utm_campaign, referrer, landing_page,
+	spam_reason, tenant_id, created_at, updated_at
+) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, now(), now())
+returning ` + strings.Join(orderColumns, ", ")
+
+	tx, err := p.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin tx | %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
 
-	row := p.pool.QueryRow(ctx, query,
+	row := tx.QueryRow(ctx, query,
 		req.Name, req.Email, req.Phone, req.MoveDate, req.MoveFrom,
 		req.MoveTo, req.PropertySize, OrderStatusCreated, req.AdditionalInfo,
+		req.Source.String(), req.UTMSource, req.UTMMedium, req.UTMCampaign, req.Referrer, req.LandingPage,
+		req.SpamReason, req.TenantID,
 	)
 
-	var (
-		order                     Order
-		propertySize, orderStatus string
-		additionInfo              sql.NullString
-	)
-	err := row.Scan(
-		&order.ID, &order.Name, &order.Email, &order.Phone,
-		&order.MoveDate, &order.MoveFrom, &order.MoveTo, &propertySize,
-		&orderStatus, &additionInfo, &order.CreatedAt, &order.UpdatedAt,
-	)
+	order, err := scanOrder(row)
 	if err != nil {
 		return nil, fmt.Errorf("failed to scan inserted order: %w", err)
 	}
 
-	order.PropertySize = NewPropertySize(propertySize)
-	order.OrderStatus = NewOrderStatus(orderStatus)
+	if err = p.enqueue(ctx, tx, EventOrderCreated, order, nil); err != nil {
+		return nil, err
+	}
 
-	if additionInfo.Valid {
-		order.AdditionalInfo = &additionInfo.String
+	if err = notify(ctx, tx, EventOrderCreated, order); err != nil {
+		return nil, err
 	}
 
-	return &order, nil
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit tx | %w", err)
+	}
+
+	return order, nil
 }
 
-func (p *Postgres) Orders(ctx context.Context, filter *Filter) ([]*Order, error) {
-	qb := squirrel.Select(
-		"id", "name", "email", "phone", "move_date", "move_from", "move_to",
-		"property_size", "status", "additional_info", "created_at", "updated_at",
-	).
-		From("moving.orders").
-		PlaceholderFormat(squirrel.Dollar)
+func scanOrder(row pgx.Row, extra ...any) (*Order, error) {
+	var (
+		order                             Order
+		propertySize, orderStatus, source string
+		arrivalWindow                     string
+	)
 
-	if filter != nil {
-		if filter.OrderStatus != nil {
-			qb = qb.Where(squirrel.Eq{"status": filter.OrderStatus.String()})
-		}
+	dest := []any{
+		&order.ID, &order.TenantID, &order.Name, &order.Email, &order.Phone, &order.MoveDate, &order.MoveFrom, &order.MoveTo,
+		&propertySize, &orderStatus, &order.AdditionalInfo, &order.ReviewSecret,
+		&source, &order.UTMSource, &order.UTMMedium, &order.UTMCampaign, &order.Referrer, &order.LandingPage,
+		&order.SpamReason, &arrivalWindow, &order.CreatedAt, &order.UpdatedAt, &order.UpdatedBy,
+	}
 
-		if filter.PropertySize != nil {
-			qb = qb.Where(squirrel.Eq{"property_size": filter.PropertySize.String()})
-		}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
 
-		if filter.CreatedFrom != nil {
-			qb = qb.Where(squirrel.GtOrEq{"created_at": *filter.CreatedFrom})
-		}
+	order.PropertySize = NewPropertySize(propertySize)
+	order.OrderStatus = NewOrderStatus(orderStatus)
+	order.Source = NewLeadSource(source)
+	order.ArrivalWindow = NewArrivalWindow(arrivalWindow)
 
-		if filter.CreatedTo != nil {
-			qb = qb.Where(squirrel.LtOrEq{"created_at": *filter.CreatedTo})
-		}
+	return &order, nil
+}
 
-		if filter.MoveDateFrom != nil {
-			qb = qb.Where(squirrel.GtOrEq{"move_date": *filter.MoveDateFrom})
-		}
+func (p *Postgres) Orders(ctx context.Context, tenantID uint64, filter *Filter) ([]*Order, error) {
+	qb := squirrel.Select(orderColumns...).
+		From("moving.orders").
+		PlaceholderFormat(squirrel.Dollar)
 
-		if filter.MoveDateTo != nil {
-			qb = qb.Where(squirrel.LtOrEq{"move_date": *filter.MoveDateTo})
-		}
-	}
+	qb = applyFilter(qb, tenantID, filter)
 
 	qb = qb.OrderBy("created_at desc")
 
@@ -112,21 +126,12 @@ func (p *Postgres) Orders(ctx context.Context, filter *Filter) ([]*Order, error)
 	var orders []*Order
 
 	for rows.Next() {
-		var (
-			order                     Order
-			propertySize, orderStatus string
-		)
-		if err = rows.Scan(
-			&order.ID, &order.Name, &order.Email, &order.Phone, &order.MoveDate, &order.MoveFrom, &order.MoveTo,
-			&propertySize, &orderStatus, &order.AdditionalInfo, &order.CreatedAt, &order.UpdatedAt,
-		); err != nil {
-			return nil, fmt.Errorf("failed scan order | %w", err)
+		order, scanErr := scanOrder(rows)
+		if scanErr != nil {
+			return nil, fmt.Errorf("failed scan order | %w", scanErr)
 		}
 
-		order.PropertySize = NewPropertySize(propertySize)
-		order.OrderStatus = NewOrderStatus(orderStatus)
-
-		orders = append(orders, &order)
+		orders = append(orders, order)
 	}
 
 	if err = rows.Err(); err != nil {
@@ -140,26 +145,271 @@ func (p *Postgres) Orders(ctx context.Context, filter *Filter) ([]*Order, error)
 	return orders, nil
 }
 
-func (p *Postgres) OrderByID(ctx context.Context, id uint64) (*Order, error) {
+// OrdersStats aggregates orders of the tenant matching the filter by status, property size, source and period.
+func (p *Postgres) OrdersStats(
+	ctx context.Context, tenantID uint64, filter *Filter, granularity StatsGranularity,
+) (*Stats, error) {
+	if granularity == StatsGranularityUnknown {
+		granularity = StatsGranularityDay
+	}
+
+	// one snapshot for every count, so the breakdowns add up to the same total
+	tx, err := p.pool.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin tx | %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	byStatus, err := countBy(ctx, tx, tenantID, filter, "status")
+	if err != nil {
+		return nil, fmt.Errorf("failed to count orders by status | %w", err)
+	}
+
+	byPropertySize, err := countBy(ctx, tx, tenantID, filter, "property_size")
+	if err != nil {
+		return nil, fmt.Errorf("failed to count orders by property size | %w", err)
+	}
+
+	bySource, err := countBy(ctx, tx, tenantID, filter, "source")
+	if err != nil {
+		return nil, fmt.Errorf("failed to count orders by source | %w", err)
+	}
+
+	byCreatedAt, err := countByPeriod(ctx, tx, tenantID, filter, "created_at", granularity)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count orders by created at | %w", err)
+	}
+
+	byMoveDate, err := countByPeriod(ctx, tx, tenantID, filter, "move_date", granularity)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count orders by move date | %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit tx | %w", err)
+	}
+
+	stats := &Stats{
+		ByOrderStatus:  make(map[OrderStatus]uint64, len(byStatus)),
+		ByPropertySize: make(map[PropertySize]uint64, len(byPropertySize)),
+		BySource:       make(map[LeadSource]uint64, len(bySource)),
+		ByCreatedAt:    byCreatedAt,
+		ByMoveDate:     byMoveDate,
+	}
+
+	for status, count := range byStatus {
+		stats.ByOrderStatus[NewOrderStatus(status)] += count
+		stats.Total += count
+	}
+
+	for propertySize, count := range byPropertySize {
+		stats.ByPropertySize[NewPropertySize(propertySize)] += count
+	}
+
+	for source, count := range bySource {
+		stats.BySource[NewLeadSource(source)] += count
+	}
+
+	return stats, nil
+}
+
+func countBy(
+	ctx context.Context, tx pgx.Tx, tenantID uint64, filter *Filter, column string,
+) (map[string]uint64, error) {
+	qb := squirrel.Select(column+"::text", "count(*)").
+		From("moving.orders").
+		GroupBy(column).
+		PlaceholderFormat(squirrel.Dollar)
+
+	qb = applyFilter(qb, tenantID, filter)
+
+	query, args, err := qb.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query | %w", err)
+	}
+
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query counts | %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[string]uint64)
+
+	for rows.Next() {
+		var (
+			value string
+			count uint64
+		)
+		if err = rows.Scan(&value, &count); err != nil {
+			return nil, fmt.Errorf("failed scan count | %w", err)
+		}
+
+		counts[value] = count
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed get counts | %w", err)
+	}
+
+	return counts, nil
+}
+
+func countByPeriod(
+	ctx context.Context, tx pgx.Tx, tenantID uint64, filter *Filter, column string, granularity StatsGranularity,
+) ([]PeriodCount, error) {
+	// granularity is an enum, so it is safe to inline it into the query.
+	period := fmt.Sprintf("date_trunc('%s', %s)::timestamp", granularity.String(), column)
+
+	qb := squirrel.Select(period, "count(*)").
+		From("moving.orders").
+		GroupBy("1").
+		OrderBy("1").
+		PlaceholderFormat(squirrel.Dollar)
+
+	qb = applyFilter(qb, tenantID, filter)
+
+	query, args, err := qb.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query | %w", err)
+	}
+
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query period counts | %w", err)
+	}
+	defer rows.Close()
+
+	var counts []PeriodCount
+
+	for rows.Next() {
+		var count PeriodCount
+		if err = rows.Scan(&count.PeriodStart, &count.Count); err != nil {
+			return nil, fmt.Errorf("failed scan period count | %w", err)
+		}
+
+		counts = append(counts, count)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed get period counts | %w", err)
+	}
+
+	return counts, nil
+}
+
+// likeEscaper makes wildcards of a search text match literally.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// applyFilter selects orders of the tenant only and hides orders flagged as spam
+// unless the filter asks for them explicitly.
+func applyFilter(qb squirrel.SelectBuilder, tenantID uint64, filter *Filter) squirrel.SelectBuilder {
+	qb = qb.Where(squirrel.Eq{"tenant_id": tenantID})
+
+	if filter == nil || !filter.Spam {
+		qb = qb.Where(squirrel.Eq{"spam_reason": nil})
+	} else {
+		qb = qb.Where(squirrel.NotEq{"spam_reason": nil})
+	}
+
+	if filter == nil {
+		return qb
+	}
+
+	if filter.OrderStatus != nil {
+		qb = qb.Where(squirrel.Eq{"status": filter.OrderStatus.String()})
+	}
+
+	if filter.PropertySize != nil {
+		qb = qb.Where(squirrel.Eq{"property_size": filter.PropertySize.String()})
+	}
+
+	if filter.Source != nil {
+		qb = qb.Where(squirrel.Eq{"source": filter.Source.String()})
+	}
+
+	if filter.CreatedFrom != nil {
+		qb = qb.Where(squirrel.GtOrEq{"created_at": *filter.CreatedFrom})
+	}
+
+	if filter.CreatedTo != nil {
+		qb = qb.Where(squirrel.LtOrEq{"created_at": *filter.CreatedTo})
+	}
+
+	if filter.MoveDateFrom != nil {
+		qb = qb.Where(squirrel.GtOrEq{"move_date": *filter.MoveDateFrom})
+	}
+
+	if filter.MoveDateTo != nil {
+		qb = qb.Where(squirrel.LtOrEq{"move_date": *filter.MoveDateTo})
+	}
+
+	if filter.Search != nil {
+		pattern := "%" + likeEscaper.Replace(*filter.Search) + "%"
+
+		qb = qb.Where(squirrel.Or{
+			squirrel.ILike{"name": pattern},
+			squirrel.ILike{"phone": pattern},
+			squirrel.ILike{"email": pattern},
+			squirrel.ILike{"move_from": pattern},
+			squirrel.ILike{"move_to": pattern},
+		})
+	}
+
+	return qb
+}
+
+// Matches tells if applyFilter would select the order of the tenant, keep them in sync.
+// The tenant of the order is checked by the caller.
+func (f *Filter) Matches(order *Order) bool {
+	if (f == nil || !f.Spam) != (order.SpamReason == nil) {
+		return false
+	}
+
+	if f == nil {
+		return true
+	}
+
+	switch {
+	case f.OrderStatus != nil && *f.OrderStatus != order.OrderStatus,
+		f.PropertySize != nil && *f.PropertySize != order.PropertySize,
+		f.Source != nil && *f.Source != order.Source,
+		f.CreatedFrom != nil && order.CreatedAt.Before(*f.CreatedFrom),
+		f.CreatedTo != nil && order.CreatedAt.After(*f.CreatedTo),
+		f.MoveDateFrom != nil && order.MoveDate.Before(*f.MoveDateFrom),
+		f.MoveDateTo != nil && order.MoveDate.After(*f.MoveDateTo):
+		return false
+	}
+
+	if f.Search == nil {
+		return true
+	}
+
+	search := strings.ToLower(*f.Search)
+
+	fields := []string{order.Name, order.Phone, order.MoveFrom, order.MoveTo}
+	if order.Email != nil {
+		fields = append(fields, *order.Email)
+	}
+
+	for _, field := range fields {
+		if strings.Contains(strings.ToLower(field), search) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p *Postgres) OrderByID(ctx context.Context, tenantID, id uint64) (*Order, error) {
 	query := `
-select
-	id, name, email, phone, move_date, move_from, move_to,
-	property_size, status, additional_info, created_at, updated_at
+select ` + strings.Join(orderColumns, ", ") + `
 from moving.orders
-where id = $1
+where id = $1 and tenant_id = $2
 `
 
-	row := p.pool.QueryRow(ctx, query, id)
-
-	var (
-		order                     Order
-		propertySize, orderStatus string
-	)
-
-	if err := row.Scan(
-		&order.ID, &order.Name, &order.Email, &order.Phone, &order.MoveDate, &order.MoveFrom, &order.MoveTo,
-		&propertySize, &orderStatus, &order.AdditionalInfo, &order.CreatedAt, &order.UpdatedAt,
-	); err != nil {
+	order, err := scanOrder(p.pool.QueryRow(ctx, query, id, tenantID))
+	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, ErrNotFound
 		}
@@ -167,56 +417,146 @@ where id = $1
 		return nil, fmt.Errorf("failed scan order | %w", err)
 	}
 
-	order.PropertySize = NewPropertySize(propertySize)
-	order.OrderStatus = NewOrderStatus(orderStatus)
-
-	return &order, nil
+	return order, nil
 }
 
-func (p *Postgres) UpdateOrder(ctx context.Context, req *UpdateOrderRequest) error {
+func (p *Postgres) UpdateOrder(ctx context.Context, req *UpdateOrderRequest) (*UpdatedOrder, error) {
 	if req.ID == 0 {
-		return fmt.Errorf("invalid id")
+		return nil, fmt.Errorf("invalid id")
 	}
 
 	// Prepare arguments with proper types
-	var propertySizeStr, orderStatusStr interface{}
+	var propertySizeStr, orderStatusStr, arrivalWindowStr interface{}
 	if req.PropertySize != nil {
 		propertySizeStr = req.PropertySize.String()
 	}
 	if req.OrderStatus != nil {
 		orderStatusStr = req.OrderStatus.String()
 	}
+	if req.ArrivalWindow != nil {
+		arrivalWindowStr = req.ArrivalWindow.String()
+	}
 
 	query := `
-update moving.orders
+with previous as (
+	select id, status from moving.orders where id = $10 and tenant_id = $13 for update
+)
+update moving.orders o
 set
-	property_size = coalesce($1, property_size),
-	status = coalesce($2, status),
-	move_date = coalesce($3, move_date),
-	name = coalesce(nullif($4, ''), name),
-	email = coalesce(nullif($5, ''), email),
-	phone = coalesce(nullif($6, ''), phone),
-	move_from = coalesce(nullif($7, ''), move_from),
-	move_to = coalesce(nullif($8, ''), move_to),
-	additional_info = coalesce($9, additional_info),
-	updated_at = now()
-where id = $10
+	property_size = coalesce($1, o.property_size),
+	status = coalesce($2, o.status),
+	move_date = coalesce($3, o.move_date),
+	name = coalesce(nullif($4, ''), o.name),
+	email = coalesce(nullif($5, ''), o.email),
+	phone = coalesce(nullif($6, ''), o.phone),
+	move_from = coalesce(nullif($7, ''), o.move_from),
+	move_to = coalesce(nullif($8, ''), o.move_to),
+	additional_info = coalesce($9, o.additional_info),
+	arrival_window = coalesce($11, o.arrival_window),
+	updated_at = now(),
+	updated_by = $12
+from previous
+where o.id = previous.id
+returning o.` + strings.Join(orderColumns, ", o.") + `, previous.status
 `
 
 	args := []interface{}{
 		propertySizeStr, orderStatusStr, req.MoveDate, req.Name,
 		req.Email, req.Phone, req.MoveFrom, req.MoveTo,
-		req.AdditionalInfo, req.ID,
+		req.AdditionalInfo, req.ID, arrivalWindowStr, req.UpdatedBy, req.TenantID,
+	}
+
+	tx, err := p.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin tx | %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	var previousStatus string
+
+	order, err := scanOrder(tx.QueryRow(ctx, query, args...), &previousStatus)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+
+		return nil, fmt.Errorf("failed update row | %w", err)
+	}
+
+	updated := &UpdatedOrder{
+		Order:          order,
+		PreviousStatus: NewOrderStatus(previousStatus),
+	}
+
+	if err = p.enqueue(ctx, tx, EventOrderUpdated, order, &updated.PreviousStatus); err != nil {
+		return nil, err
 	}
 
-	if _, err := p.pool.Exec(ctx, query, args...); err != nil {
-		return fmt.Errorf("failed update row | %w", err)
+	if err = notify(ctx, tx, EventOrderUpdated, order); err != nil {
+		return nil, err
 	}
 
-	return nil
+	if updated.PreviousStatus != order.OrderStatus {
+		if err = p.enqueue(ctx, tx, EventOrderStatusChanged, order, &updated.PreviousStatus); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit tx | %w", err)
+	}
+
+	return updated, nil
+}
+
+// OpenOrdersByStatus counts orders which are not rejected and not done yet by tenant slug and status,
+// every status of an active tenant is counted even if it has no orders.
+func (p *Postgres) OpenOrdersByStatus(ctx context.Context) (map[string]map[string]uint64, error) {
+	query := `
+select t.slug, s.status, count(o.id)
+from moving.tenants t
+cross join unnest($1::text[]) as s (status)
+left join moving.orders o
+	on o.tenant_id = t.id and o.status::text = s.status and o.spam_reason is null
+where t.disabled_at is null
+group by t.slug, s.status
+`
+
+	openStatuses := []string{OrderStatusCreated.String(), OrderStatusInProgress.String()}
+
+	rows, err := p.pool.Query(ctx, query, openStatuses)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query open orders | %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[string]map[string]uint64)
+
+	for rows.Next() {
+		var (
+			tenant, status string
+			count          uint64
+		)
+		if err = rows.Scan(&tenant, &status, &count); err != nil {
+			return nil, fmt.Errorf("failed scan open orders count | %w", err)
+		}
+
+		if counts[tenant] == nil {
+			counts[tenant] = make(map[string]uint64, len(openStatuses))
+		}
+
+		counts[tenant][status] = count
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed get open orders | %w", err)
+	}
+
+	return counts, nil
 }
 
 type CreateOrderRequest struct {
+	TenantID       uint64
 	PropertySize   PropertySize
 	MoveDate       time.Time
 	Name           string
@@ -225,10 +565,18 @@ type CreateOrderRequest struct {
 	MoveFrom       string
 	MoveTo         string
 	AdditionalInfo *string
+	Source         LeadSource
+	UTMSource      *string
+	UTMMedium      *string
+	UTMCampaign    *string
+	Referrer       *string
+	LandingPage    *string
+	SpamReason     *string
 }
 
 type Order struct {
 	ID             uint64
+	TenantID       uint64
 	PropertySize   PropertySize
 	OrderStatus    OrderStatus
 	MoveDate       time.Time
@@ -240,10 +588,22 @@ type Order struct {
 	MoveFrom       string
 	MoveTo         string
 	AdditionalInfo *string
+	ReviewSecret   string
+	Source         LeadSource
+	UTMSource      *string
+	UTMMedium      *string
+	UTMCampaign    *string
+	Referrer       *string
+	LandingPage    *string
+	SpamReason     *string
+	ArrivalWindow  ArrivalWindow
+	UpdatedBy      *uint64
 }
 
+// UpdateOrderRequest the order is updated only if it belongs to TenantID.
 type UpdateOrderRequest struct {
 	ID             uint64
+	TenantID       uint64
 	PropertySize   *PropertySize
 	OrderStatus    *OrderStatus
 	MoveDate       *time.Time
@@ -253,13 +613,38 @@ type UpdateOrderRequest struct {
 	MoveFrom       *string
 	MoveTo         *string
 	AdditionalInfo *string
+	ArrivalWindow  *ArrivalWindow
+	UpdatedBy      *uint64
+}
+
+type UpdatedOrder struct {
+	Order          *Order
+	PreviousStatus OrderStatus
 }
 
 type Filter struct {
 	OrderStatus  *OrderStatus
 	PropertySize *PropertySize
+	Source       *LeadSource
+	Spam         bool
 	CreatedFrom  *time.Time
 	CreatedTo    *time.Time
 	MoveDateFrom *time.Time
 	MoveDateTo   *time.Time
+	// Search matches a substring of the name, phone, email or addresses.
+	Search *string
+}
+
+type Stats struct {
+	Total          uint64
+	ByOrderStatus  map[OrderStatus]uint64
+	ByPropertySize map[PropertySize]uint64
+	BySource       map[LeadSource]uint64
+	ByCreatedAt    []PeriodCount
+	ByMoveDate     []PeriodCount
+}
+
+type PeriodCount struct {
+	PeriodStart time.Time
+	Count       uint64
 }
@@ -0,0 +1,23 @@
+package orders
+
+type StatsGranularity int8
+
+const (
+	StatsGranularityUnknown StatsGranularity = iota
+	StatsGranularityDay
+	StatsGranularityWeek
+	StatsGranularityMonth
+)
+
+func (g StatsGranularity) String() string {
+	switch g {
+	case StatsGranularityDay:
+		return "day"
+	case StatsGranularityWeek:
+		return "week"
+	case StatsGranularityMonth:
+		return "month"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,181 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Postgres struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgres(pool *pgxpool.Pool) *Postgres {
+	return &Postgres{pool: pool}
+}
+
+// Claim takes due pending events and hides them from other replicas until the lease expires,
+// so an event is retried if the replica dies while delivering it.
+func (p *Postgres) Claim(ctx context.Context, limit int, lease time.Duration) ([]*Event, error) {
+	query := `
+update moving.outbox o
+set attempts = o.attempts + 1, next_attempt_at = $2
+where o.id in (
+	select id
+	from moving.outbox
+	where status = 'pending' and next_attempt_at <= $3
+	order by id
+	limit $1
+	for update skip locked
+)
+returning o.id, o.destination, o.event_type, o.aggregate_id, o.payload, o.attempts, o.created_at
+`
+
+	now := time.Now().UTC()
+
+	rows, err := p.pool.Query(ctx, query, limit, now.Add(lease), now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim outbox events | %w", err)
+	}
+	defer rows.Close()
+
+	var events []*Event
+
+	for rows.Next() {
+		var event Event
+		if err = rows.Scan(
+			&event.ID, &event.Destination, &event.EventType, &event.AggregateID,
+			&event.Payload, &event.Attempts, &event.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed scan outbox event | %w", err)
+		}
+
+		events = append(events, &event)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed get outbox events | %w", err)
+	}
+
+	return events, nil
+}
+
+func (p *Postgres) MarkDelivered(ctx context.Context, id uint64) error {
+	query := `
+update moving.outbox
+set status = 'delivered', delivered_at = now(), last_error = null
+where id = $1
+`
+
+	if _, err := p.pool.Exec(ctx, query, id); err != nil {
+		return fmt.Errorf("failed to mark outbox event delivered | %w", err)
+	}
+
+	return nil
+}
+
+func (p *Postgres) Retry(ctx context.Context, id uint64, nextAttemptAt time.Time, lastError string) error {
+	query := `
+update moving.outbox
+set next_attempt_at = $2, last_error = $3
+where id = $1
+`
+
+	if _, err := p.pool.Exec(ctx, query, id, nextAttemptAt.UTC(), lastError); err != nil {
+		return fmt.Errorf("failed to schedule outbox event retry | %w", err)
+	}
+
+	return nil
+}
+
+func (p *Postgres) MarkDead(ctx context.Context, id uint64, lastError string) error {
+	query := `
+update moving.outbox
+set status = 'dead', last_error = $2
+where id = $1
+`
+
+	if _, err := p.pool.Exec(ctx, query, id, lastError); err != nil {
+		return fmt.Errorf("failed to mark outbox event dead | %w", err)
+	}
+
+	return nil
+}
+
+// PendingByDestination counts events waiting for delivery.
+func (p *Postgres) PendingByDestination(ctx context.Context) (map[string]uint64, error) {
+	query := `
+select destination, count(*)
+from moving.outbox
+where status = 'pending'
+group by destination
+`
+
+	rows, err := p.pool.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query pending outbox events | %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[string]uint64)
+
+	for rows.Next() {
+		var (
+			destination string
+			count       uint64
+		)
+		if err = rows.Scan(&destination, &count); err != nil {
+			return nil, fmt.Errorf("failed scan pending outbox count | %w", err)
+		}
+
+		counts[destination] = count
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed get pending outbox counts | %w", err)
+	}
+
+	return counts, nil
+}
+
+// Lag is how long the oldest due event waits for delivery, it is zero when no event is due.
+// Events being delivered and events waiting for a retry are not due.
+func (p *Postgres) Lag(ctx context.Context) (time.Duration, error) {
+	query := `select min(next_attempt_at) from moving.outbox where status = 'pending' and next_attempt_at <= $1`
+
+	now := time.Now().UTC()
+
+	var oldest *time.Time
+	if err := p.pool.QueryRow(ctx, query, now).Scan(&oldest); err != nil {
+		return 0, fmt.Errorf("failed to get outbox lag | %w", err)
+	}
+
+	if oldest == nil {
+		return 0, nil
+	}
+
+	return now.Sub(*oldest), nil
+}
+
+// PurgeDelivered drops delivered events, dead ones are kept for investigation.
+func (p *Postgres) PurgeDelivered(ctx context.Context, before time.Time) error {
+	query := `delete from moving.outbox where status = 'delivered' and delivered_at < $1`
+
+	if _, err := p.pool.Exec(ctx, query, before.UTC()); err != nil {
+		return fmt.Errorf("failed to purge outbox | %w", err)
+	}
+
+	return nil
+}
+
+type Event struct {
+	ID          uint64
+	Destination string
+	EventType   string
+	AggregateID uint64
+	Payload     []byte
+	Attempts    int
+	CreatedAt   time.Time
+}
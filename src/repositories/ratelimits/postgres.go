@@ -0,0 +1,66 @@
+package ratelimits
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Postgres is a fixed window rate limiter shared by all replicas.
+type Postgres struct {
+	pool *pgxpool.Pool
+
+	mutex     sync.Mutex
+	lastPurge time.Time
+}
+
+func NewPostgres(pool *pgxpool.Pool) *Postgres {
+	return &Postgres{pool: pool}
+}
+
+func (p *Postgres) Allow(ctx context.Context, key string, limit int, window time.Duration) (bool, error) {
+	start := time.Now().UTC().Truncate(window)
+
+	if err := p.purge(ctx, start); err != nil {
+		return false, err
+	}
+
+	query := `
+insert into moving.rate_limits (key, window_start, hits)
+values ($1, $2, 1)
+on conflict (key) do update set
+	hits = case
+		when moving.rate_limits.window_start = excluded.window_start then moving.rate_limits.hits + 1
+		else 1
+	end,
+	window_start = excluded.window_start
+returning hits
+`
+
+	var hits int
+	if err := p.pool.QueryRow(ctx, query, key, start).Scan(&hits); err != nil {
+		return false, fmt.Errorf("failed to count hit | %w", err)
+	}
+
+	return hits <= limit, nil
+}
+
+// purge drops expired windows at most once per window.
+func (p *Postgres) purge(ctx context.Context, start time.Time) error {
+	p.mutex.Lock()
+	if !p.lastPurge.Before(start) {
+		p.mutex.Unlock()
+		return nil
+	}
+	p.lastPurge = start
+	p.mutex.Unlock()
+
+	if _, err := p.pool.Exec(ctx, `delete from moving.rate_limits where window_start < $1`, start); err != nil {
+		return fmt.Errorf("failed to purge rate limits | %w", err)
+	}
+
+	return nil
+}
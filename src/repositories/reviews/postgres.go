@@ -7,38 +7,77 @@ import (
 	"sync"
 	"time"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
-var ErrNotFound = errors.New("not found")
+const (
+	SourceYelp   = "yelp"
+	SourceDirect = "direct"
+
+	uniqueViolationCode = "23505"
+
+	// cacheTTL is how long a review approved on another replica may stay unseen on this one.
+	cacheTTL = time.Minute
+)
+
+var (
+	ErrNotFound      = errors.New("not found")
+	ErrAlreadyExists = errors.New("already exists")
+)
+
+type cachedReviews struct {
+	reviews  []*Review
+	cachedAt time.Time
+}
 
 type Postgres struct {
 	pool *pgxpool.Pool
 
-	reviews []*Review
+	// reviews are the approved reviews by tenant, cached for cacheTTL.
+	reviews map[uint64]cachedReviews
 	mutex   sync.RWMutex
 }
 
 func NewPostgres(pool *pgxpool.Pool) *Postgres {
-	return &Postgres{pool: pool}
+	return &Postgres{pool: pool, reviews: make(map[uint64]cachedReviews)}
 }
 
-func (p *Postgres) Reviews(ctx context.Context) ([]*Review, error) {
+func (p *Postgres) Reviews(ctx context.Context, tenantID uint64) ([]*Review, error) {
 	p.mutex.RLock()
-	if p.reviews != nil {
+	if cached, ok := p.reviews[tenantID]; ok && time.Since(cached.cachedAt) < cacheTTL {
 		defer p.mutex.RUnlock()
-		return p.reviews, nil
+		return cached.reviews, nil
 	}
 	p.mutex.RUnlock()
 
+	reviews, err := p.reviewsByStatus(ctx, tenantID, ReviewStatusApproved)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mutex.Lock()
+	p.reviews[tenantID] = cachedReviews{reviews: reviews, cachedAt: time.Now()}
+	p.mutex.Unlock()
+
+	return reviews, nil
+}
+
+func (p *Postgres) PendingReviews(ctx context.Context, tenantID uint64) ([]*Review, error) {
+	return p.reviewsByStatus(ctx, tenantID, ReviewStatusPending)
+}
+
+func (p *Postgres) reviewsByStatus(ctx context.Context, tenantID uint64, status ReviewStatus) ([]*Review, error) {
 	query := `
 select
-	id, name, rate, photo_url, text, review_url, created_at, updated_at
+	id, name, rate, photo_url, text, review_url, source, status, order_id, created_at, updated_at
 from moving.reviews
+where tenant_id = $1 and status = $2
 order by id
 `
 
-	rows, err := p.pool.Query(ctx, query)
+	rows, err := p.pool.Query(ctx, query, tenantID, status.String())
 	if err != nil {
 		return nil, fmt.Errorf("failed to query reviews | %w", err)
 	}
@@ -47,15 +86,20 @@ order by id
 	var reviews []*Review
 
 	for rows.Next() {
-		var review Review
+		var (
+			review       Review
+			reviewStatus string
+		)
 
 		if err = rows.Scan(
-			&review.ID, &review.Name, &review.Rate, &review.PhotoURL,
-			&review.Text, &review.URL, &review.CreatedAt, &review.UpdatedAt,
+			&review.ID, &review.Name, &review.Rate, &review.PhotoURL, &review.Text, &review.URL,
+			&review.Source, &reviewStatus, &review.OrderID, &review.CreatedAt, &review.UpdatedAt,
 		); err != nil {
 			return nil, fmt.Errorf("failed scan review | %w", err)
 		}
 
+		review.Status = NewReviewStatus(reviewStatus)
+
 		reviews = append(reviews, &review)
 	}
 
@@ -67,20 +111,93 @@ order by id
 		return nil, ErrNotFound
 	}
 
-	p.mutex.Lock()
-	p.reviews = reviews
-	p.mutex.Unlock()
-
 	return reviews, nil
 }
 
+func (p *Postgres) SubmitReview(ctx context.Context, req *SubmitReviewRequest) (*Review, error) {
+	query := `
+insert into moving.reviews (
+	tenant_id, name, rate, photo_url, text, review_url, source, status, order_id, created_at, updated_at
+) values ($1, $2, $3, '', $4, '', $5, $6, $7, now(), now())
+returning id, name, rate, photo_url, text, review_url, source, status, order_id, created_at, updated_at;
+`
+
+	row := p.pool.QueryRow(ctx, query,
+		req.TenantID, req.Name, req.Rate, req.Text, SourceDirect, ReviewStatusPending.String(), req.OrderID,
+	)
+
+	var (
+		review       Review
+		reviewStatus string
+	)
+	if err := row.Scan(
+		&review.ID, &review.Name, &review.Rate, &review.PhotoURL, &review.Text, &review.URL,
+		&review.Source, &reviewStatus, &review.OrderID, &review.CreatedAt, &review.UpdatedAt,
+	); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode {
+			return nil, ErrAlreadyExists
+		}
+
+		return nil, fmt.Errorf("failed to scan inserted review | %w", err)
+	}
+
+	review.Status = NewReviewStatus(reviewStatus)
+
+	return &review, nil
+}
+
+// ModerateReview moves a pending review of the tenant into the given status.
+func (p *Postgres) ModerateReview(ctx context.Context, tenantID, id uint64, status ReviewStatus) error {
+	query := `
+update moving.reviews
+set
+	status = $1,
+	moderated_at = now(),
+	updated_at = now()
+where id = $2 and tenant_id = $3 and status = $4
+returning id
+`
+
+	var reviewID uint64
+	if err := p.pool.QueryRow(
+		ctx, query, status.String(), id, tenantID, ReviewStatusPending.String(),
+	).Scan(&reviewID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrNotFound
+		}
+
+		return fmt.Errorf("failed update review | %w", err)
+	}
+
+	// other replicas pick the approved review up within cacheTTL
+	if status == ReviewStatusApproved {
+		p.mutex.Lock()
+		delete(p.reviews, tenantID)
+		p.mutex.Unlock()
+	}
+
+	return nil
+}
+
 type Review struct {
 	ID        uint64
+	OrderID   *uint64
 	Rate      int32
 	Text      string
 	Name      string
 	PhotoURL  string
 	URL       string
+	Source    string
+	Status    ReviewStatus
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
+
+type SubmitReviewRequest struct {
+	TenantID uint64
+	OrderID  uint64
+	Name     string
+	Rate     int32
+	Text     string
+}
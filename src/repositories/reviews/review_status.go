@@ -0,0 +1,36 @@
+package reviews
+
+type ReviewStatus int8
+
+const (
+	ReviewStatusUnknown ReviewStatus = iota
+	ReviewStatusPending
+	ReviewStatusApproved
+	ReviewStatusRejected
+)
+
+func (s ReviewStatus) String() string {
+	switch s {
+	case ReviewStatusPending:
+		return "pending"
+	case ReviewStatusApproved:
+		return "approved"
+	case ReviewStatusRejected:
+		return "rejected"
+	default:
+		return "unknown"
+	}
+}
+
+func NewReviewStatus(s string) ReviewStatus {
+	switch s {
+	case "pending":
+		return ReviewStatusPending
+	case "approved":
+		return ReviewStatusApproved
+	case "rejected":
+		return ReviewStatusRejected
+	default:
+		return ReviewStatusUnknown
+	}
+}
@@ -0,0 +1,171 @@
+package sms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	KindOrderConfirmation = "order_confirmation"
+	KindMoveReminder      = "move_reminder"
+)
+
+// ErrAlreadySent is returned by Reserve when the message was sent or is being sent right now.
+var ErrAlreadySent = errors.New("already sent")
+
+// abandonedAfter a queued message without provider id is considered lost and may be sent again.
+const abandonedAfter = 5 * time.Minute
+
+type Postgres struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgres(pool *pgxpool.Pool) *Postgres {
+	return &Postgres{pool: pool}
+}
+
+// retryable matches the messages m Reserve takes again: a failure worth a retry or a queued message
+// abandoned by a stopped replica, while attempts are left. Rejected and unconfirmed messages are final.
+const retryable = `m.provider_id is null and m.attempts < $5 and (
+	m.status = 'failed' or (m.status = 'queued' and m.updated_at < $4)
+)`
+
+// Reserve creates a queued message, a message is sent at most once per order and kind
+// unless the previous attempt failed with a retryable error and maxAttempts are not used up.
+func (p *Postgres) Reserve(ctx context.Context, orderID uint64, kind, phone string, maxAttempts int) (uint64, error) {
+	query := `
+insert into moving.sms_messages as m (order_id, kind, phone)
+values ($1, $2, $3)
+on conflict (order_id, kind) do update set
+	phone = excluded.phone,
+	status = 'queued',
+	error = null,
+	attempts = m.attempts + 1,
+	updated_at = now()
+where ` + retryable + `
+returning id
+`
+
+	var id uint64
+	if err := p.pool.QueryRow(
+		ctx, query, orderID, kind, phone, time.Now().UTC().Add(-abandonedAfter), maxAttempts,
+	).Scan(&id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, ErrAlreadySent
+		}
+
+		return 0, fmt.Errorf("failed to reserve sms message | %w", err)
+	}
+
+	return id, nil
+}
+
+func (p *Postgres) MarkSent(ctx context.Context, id uint64, body, providerID, status string) error {
+	query := `
+update moving.sms_messages
+set body = $2, provider_id = $3, status = $4, updated_at = now()
+where id = $1
+`
+
+	if _, err := p.pool.Exec(ctx, query, id, body, providerID, status); err != nil {
+		return fmt.Errorf("failed to mark sms message sent | %w", err)
+	}
+
+	return nil
+}
+
+// MarkFailed status is failed for a retryable failure, rejected or unconfirmed for a final one.
+func (p *Postgres) MarkFailed(ctx context.Context, id uint64, body, status, sendErr string) error {
+	query := `
+update moving.sms_messages
+set body = $2, status = $3, error = $4, updated_at = now()
+where id = $1
+`
+
+	if _, err := p.pool.Exec(ctx, query, id, body, status, sendErr); err != nil {
+		return fmt.Errorf("failed to mark sms message failed | %w", err)
+	}
+
+	return nil
+}
+
+// UpdateStatus records a provider callback, final statuses are not overwritten by late ones.
+func (p *Postgres) UpdateStatus(ctx context.Context, providerID, status string, errorCode *string) error {
+	query := `
+update moving.sms_messages
+set status = $2, error = coalesce($3, error), updated_at = now()
+where provider_id = $1 and status not in ('delivered', 'undelivered', 'failed')
+`
+
+	if _, err := p.pool.Exec(ctx, query, providerID, status, errorCode); err != nil {
+		return fmt.Errorf("failed to update sms status | %w", err)
+	}
+
+	return nil
+}
+
+// OrdersToRemind returns open orders moving on the given day without a reminder,
+// orders whose reminder Reserve would send again are returned as well.
+func (p *Postgres) OrdersToRemind(ctx context.Context, moveDay time.Time, maxAttempts int) ([]*Order, error) {
+	query := `
+select o.id, o.tenant_id, o.name, o.phone, o.move_date, o.move_from, o.move_to,
+	t.name, t.brand_phone
+from moving.orders o
+	join moving.tenants t on t.id = o.tenant_id
+where o.move_date::date = $1::date
+	and o.status::text = any($2)
+	and o.spam_reason is null
+	and not exists (
+		select 1
+		from moving.sms_messages m
+		where m.order_id = o.id and m.kind = $3 and not (` + retryable + `)
+	)
+order by o.id
+`
+
+	rows, err := p.pool.Query(
+		ctx, query, moveDay, []string{"created", "in_progress"}, KindMoveReminder,
+		time.Now().UTC().Add(-abandonedAfter), maxAttempts,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query orders to remind | %w", err)
+	}
+	defer rows.Close()
+
+	var orders []*Order
+
+	for rows.Next() {
+		var order Order
+		if err = rows.Scan(
+			&order.ID, &order.TenantID, &order.Name, &order.Phone, &order.MoveDate, &order.MoveFrom, &order.MoveTo,
+			&order.BrandName, &order.BrandPhone,
+		); err != nil {
+			return nil, fmt.Errorf("failed scan order to remind | %w", err)
+		}
+
+		orders = append(orders, &order)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed get orders to remind | %w", err)
+	}
+
+	return orders, nil
+}
+
+type Order struct {
+	ID         uint64
+	TenantID   uint64
+	Name       string
+	Phone      string
+	MoveDate   time.Time
+	MoveFrom   string
+	MoveTo     string
+	BrandName  string
+	BrandPhone string
+}
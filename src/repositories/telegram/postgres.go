@@ -0,0 +1,322 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Events a chat may subscribe to, they are the preference columns as well.
+const (
+	EventNewOrders     = "new_orders"
+	EventStatusChanges = "status_changes"
+	EventDigests       = "digests"
+)
+
+var (
+	ErrNotFound      = errors.New("not found")
+	ErrInvalidInvite = errors.New("invalid invite")
+)
+
+var eventColumns = map[string]string{
+	EventNewOrders:     "new_orders",
+	EventStatusChanges: "status_changes",
+	EventDigests:       "digests",
+}
+
+const subscriptionColumns = `chat_id, tenant_id, title, new_orders, status_changes, digests, created_at`
+
+type Postgres struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgres(pool *pgxpool.Pool) *Postgres {
+	return &Postgres{pool: pool}
+}
+
+// CreateInvite the chat redeeming the invite is subscribed to the tenant.
+func (p *Postgres) CreateInvite(ctx context.Context, tenantID uint64, code string, expiresAt time.Time) (*Invite, error) {
+	query := `
+insert into moving.telegram_invites (tenant_id, code, expires_at)
+values ($1, $2, $3)
+returning id, code, expires_at, created_at
+`
+
+	var invite Invite
+	if err := p.pool.QueryRow(ctx, query, tenantID, code, expiresAt).Scan(
+		&invite.ID, &invite.Code, &invite.ExpiresAt, &invite.CreatedAt,
+	); err != nil {
+		return nil, fmt.Errorf("failed to insert telegram invite | %w", err)
+	}
+
+	return &invite, nil
+}
+
+// Redeem uses the invite up and subscribes the chat to the tenant of the invite,
+// a revoked chat is subscribed again with default preferences.
+func (p *Postgres) Redeem(ctx context.Context, code string, chatID int64, title string) (*Subscription, error) {
+	tx, err := p.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin tx | %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	var inviteID, tenantID uint64
+	if err = tx.QueryRow(ctx, `
+update moving.telegram_invites
+set used_at = now(), used_by_chat_id = $2
+where code = $1 and used_at is null and expires_at > now()
+returning id, tenant_id
+`, code, chatID).Scan(&inviteID, &tenantID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrInvalidInvite
+		}
+
+		return nil, fmt.Errorf("failed to use telegram invite | %w", err)
+	}
+
+	subscription, err := scanSubscription(tx.QueryRow(ctx, `
+insert into moving.telegram_subscriptions (chat_id, tenant_id, title, invite_id)
+values ($1, $2, $3, $4)
+on conflict (chat_id) do update set
+	tenant_id = excluded.tenant_id,
+	title = excluded.title,
+	invite_id = excluded.invite_id,
+	bootstrapped = false,
+	new_orders = true,
+	status_changes = true,
+	digests = true,
+	created_at = case
+		when moving.telegram_subscriptions.revoked_at is null then moving.telegram_subscriptions.created_at
+		else now()
+	end,
+	updated_at = now(),
+	revoked_at = null
+returning `+subscriptionColumns, chatID, tenantID, title, inviteID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to upsert telegram subscription | %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit tx | %w", err)
+	}
+
+	return subscription, nil
+}
+
+// Bootstrap makes the chats the bootstrapped chats of the tenant: new chats are subscribed, chats revoked
+// for missing from an earlier list are subscribed again and bootstrapped chats missing from the list are revoked.
+// Chats joined with an invite or revoked by hand are left as they are. An empty list revokes nothing,
+// an unset or mistyped setting must not unsubscribe every chat. It returns the revoked chats.
+func (p *Postgres) Bootstrap(ctx context.Context, tenantID uint64, chatIDs []int64) ([]int64, error) {
+	if len(chatIDs) == 0 {
+		return nil, nil
+	}
+
+	tx, err := p.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin tx | %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if _, err = tx.Exec(ctx, `
+insert into moving.telegram_subscriptions (chat_id, tenant_id, bootstrapped)
+select unnest($1::bigint[]), $2, true
+on conflict (chat_id) do update set
+	created_at = now(),
+	updated_at = now(),
+	revoked_at = null
+where moving.telegram_subscriptions.bootstrapped
+	and moving.telegram_subscriptions.tenant_id = excluded.tenant_id
+	and moving.telegram_subscriptions.revoked_at is not null
+`, chatIDs, tenantID); err != nil {
+		return nil, fmt.Errorf("failed to bootstrap telegram subscriptions | %w", err)
+	}
+
+	rows, err := tx.Query(ctx, `
+update moving.telegram_subscriptions
+set revoked_at = now(), updated_at = now()
+where tenant_id = $2 and bootstrapped and revoked_at is null and chat_id <> all($1::bigint[])
+returning chat_id
+`, chatIDs, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to revoke removed telegram subscriptions | %w", err)
+	}
+
+	revoked, err := pgx.CollectRows(rows, pgx.RowTo[int64])
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan revoked telegram subscriptions | %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit tx | %w", err)
+	}
+
+	return revoked, nil
+}
+
+// Subscription returns the chat if it is not revoked, a chat belongs to one tenant.
+func (p *Postgres) Subscription(ctx context.Context, chatID int64) (*Subscription, error) {
+	subscription, err := scanSubscription(p.pool.QueryRow(ctx, `
+select `+subscriptionColumns+`
+from moving.telegram_subscriptions
+where chat_id = $1 and revoked_at is null
+`, chatID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get telegram subscription | %w", err)
+	}
+
+	return subscription, nil
+}
+
+func (p *Postgres) Subscriptions(ctx context.Context, tenantID uint64) ([]*Subscription, error) {
+	rows, err := p.pool.Query(ctx, `
+select `+subscriptionColumns+`
+from moving.telegram_subscriptions
+where tenant_id = $1 and revoked_at is null
+order by created_at
+`, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query telegram subscriptions | %w", err)
+	}
+	defer rows.Close()
+
+	var subscriptions []*Subscription
+
+	for rows.Next() {
+		subscription, scanErr := scanSubscription(rows)
+		if scanErr != nil {
+			return nil, fmt.Errorf("failed scan telegram subscription | %w", scanErr)
+		}
+
+		subscriptions = append(subscriptions, subscription)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed get telegram subscriptions | %w", err)
+	}
+
+	if len(subscriptions) == 0 {
+		return nil, ErrNotFound
+	}
+
+	return subscriptions, nil
+}
+
+// ChatIDs returns chats of the tenant subscribed to the event.
+func (p *Postgres) ChatIDs(ctx context.Context, tenantID uint64, event string) ([]int64, error) {
+	column, ok := eventColumns[event]
+	if !ok {
+		return nil, fmt.Errorf("unknown telegram event %q", event)
+	}
+
+	rows, err := p.pool.Query(ctx, `
+select chat_id
+from moving.telegram_subscriptions
+where tenant_id = $1 and revoked_at is null and `+column+`
+order by chat_id
+`, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query telegram chats | %w", err)
+	}
+
+	chatIDs, err := pgx.CollectRows(rows, pgx.RowTo[int64])
+	if err != nil {
+		return nil, fmt.Errorf("failed scan telegram chats | %w", err)
+	}
+
+	return chatIDs, nil
+}
+
+func (p *Postgres) UpdatePreferences(
+	ctx context.Context, tenantID uint64, chatID int64, preferences *Preferences,
+) error {
+	tag, err := p.pool.Exec(ctx, `
+update moving.telegram_subscriptions
+set new_orders = $3, status_changes = $4, digests = $5, updated_at = now()
+where chat_id = $1 and tenant_id = $2 and revoked_at is null
+`, chatID, tenantID, preferences.NewOrders, preferences.StatusChanges, preferences.Digests)
+	if err != nil {
+		return fmt.Errorf("failed to update telegram preferences | %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// Revoke a chat revoked by hand is not subscribed again by Bootstrap.
+func (p *Postgres) Revoke(ctx context.Context, tenantID uint64, chatID int64) error {
+	tag, err := p.pool.Exec(ctx, `
+update moving.telegram_subscriptions
+set revoked_at = now(), updated_at = now(), bootstrapped = false
+where chat_id = $1 and tenant_id = $2 and revoked_at is null
+`, chatID, tenantID)
+	if err != nil {
+		return fmt.Errorf("failed to revoke telegram subscription | %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// ClaimDigest records the digest run of the tenant, only the first replica claiming the scheduled time gets true.
+func (p *Postgres) ClaimDigest(ctx context.Context, tenantID uint64, digest string, scheduledAt time.Time) (bool, error) {
+	tag, err := p.pool.Exec(ctx, `
+insert into moving.telegram_digest_runs (tenant_id, digest, scheduled_at)
+values ($1, $2, $3)
+on conflict (tenant_id, digest, scheduled_at) do nothing
+`, tenantID, digest, scheduledAt.UTC())
+	if err != nil {
+		return false, fmt.Errorf("failed to claim telegram digest | %w", err)
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+func scanSubscription(row pgx.Row) (*Subscription, error) {
+	var subscription Subscription
+	if err := row.Scan(
+		&subscription.ChatID, &subscription.TenantID, &subscription.Title,
+		&subscription.Preferences.NewOrders, &subscription.Preferences.StatusChanges, &subscription.Preferences.Digests,
+		&subscription.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return &subscription, nil
+}
+
+type Invite struct {
+	ID        uint64
+	Code      string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+type Preferences struct {
+	NewOrders     bool
+	StatusChanges bool
+	Digests       bool
+}
+
+type Subscription struct {
+	ChatID      int64
+	TenantID    uint64
+	Title       string
+	Preferences Preferences
+	CreatedAt   time.Time
+}
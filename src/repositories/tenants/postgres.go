@@ -0,0 +1,69 @@
+package tenants
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var ErrNotFound = errors.New("not found")
+
+type Postgres struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgres(pool *pgxpool.Pool) *Postgres {
+	return &Postgres{pool: pool}
+}
+
+// Tenants returns the tenants which are not disabled.
+func (p *Postgres) Tenants(ctx context.Context) ([]*Tenant, error) {
+	rows, err := p.pool.Query(ctx, `
+select id, slug, name, hosts, brand_phone, brand_email, brand_site_url, pricing
+from moving.tenants
+where disabled_at is null
+order by id
+`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query tenants | %w", err)
+	}
+	defer rows.Close()
+
+	var tenants []*Tenant
+
+	for rows.Next() {
+		var tenant Tenant
+		if err = rows.Scan(
+			&tenant.ID, &tenant.Slug, &tenant.Name, &tenant.Hosts,
+			&tenant.BrandPhone, &tenant.BrandEmail, &tenant.BrandSiteURL, &tenant.Pricing,
+		); err != nil {
+			return nil, fmt.Errorf("failed scan tenant | %w", err)
+		}
+
+		tenants = append(tenants, &tenant)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed get tenants | %w", err)
+	}
+
+	if len(tenants) == 0 {
+		return nil, ErrNotFound
+	}
+
+	return tenants, nil
+}
+
+// Tenant Pricing is the raw json of the pricing config.
+type Tenant struct {
+	ID           uint64
+	Slug         string
+	Name         string
+	Hosts        []string
+	BrandPhone   string
+	BrandEmail   string
+	BrandSiteURL string
+	Pricing      []byte
+}
@@ -0,0 +1,265 @@
+package users
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const uniqueViolationCode = "23505"
+
+var (
+	ErrNotFound            = errors.New("not found")
+	ErrAlreadyExists       = errors.New("already exists")
+	ErrInvalidRefreshToken = errors.New("invalid refresh token")
+)
+
+const userColumns = `u.id, u.tenant_id, u.email, u.name, u.password_hash, u.roles, u.created_at, u.disabled_at`
+
+type Postgres struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgres(pool *pgxpool.Pool) *Postgres {
+	return &Postgres{pool: pool}
+}
+
+func (p *Postgres) CreateUser(ctx context.Context, req *CreateUserRequest) (*User, error) {
+	user, err := scanUser(p.pool.QueryRow(ctx, `
+insert into moving.admin_users as u (tenant_id, email, name, password_hash, roles)
+values ($1, $2, $3, $4, $5)
+returning `+userColumns, req.TenantID, req.Email, req.Name, req.PasswordHash, req.Roles))
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode {
+			return nil, ErrAlreadyExists
+		}
+
+		return nil, fmt.Errorf("failed to insert admin user | %w", err)
+	}
+
+	return user, nil
+}
+
+// UserByEmail returns the user if it is not disabled.
+func (p *Postgres) UserByEmail(ctx context.Context, email string) (*User, error) {
+	user, err := scanUser(p.pool.QueryRow(ctx, `
+select `+userColumns+`
+from moving.admin_users u
+where lower(u.email) = lower($1) and u.disabled_at is null
+`, email))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get admin user | %w", err)
+	}
+
+	return user, nil
+}
+
+// Users returns the users of the tenant, every user if the tenant is nil.
+func (p *Postgres) Users(ctx context.Context, tenantID *uint64) ([]*User, error) {
+	rows, err := p.pool.Query(ctx, `
+select `+userColumns+`
+from moving.admin_users u
+where $1::int is null or u.tenant_id = $1
+order by u.id
+`, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query admin users | %w", err)
+	}
+	defer rows.Close()
+
+	var users []*User
+
+	for rows.Next() {
+		user, scanErr := scanUser(rows)
+		if scanErr != nil {
+			return nil, fmt.Errorf("failed scan admin user | %w", scanErr)
+		}
+
+		users = append(users, user)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed get admin users | %w", err)
+	}
+
+	if len(users) == 0 {
+		return nil, ErrNotFound
+	}
+
+	return users, nil
+}
+
+// DisableUser forbids the user to log in and revokes its refresh tokens.
+// The user must belong to the tenant unless the tenant is nil.
+func (p *Postgres) DisableUser(ctx context.Context, tenantID *uint64, id uint64) error {
+	tx, err := p.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin tx | %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	tag, err := tx.Exec(ctx, `
+update moving.admin_users
+set disabled_at = now(), updated_at = now()
+where id = $1 and ($2::int is null or tenant_id = $2) and disabled_at is null
+`, id, tenantID)
+	if err != nil {
+		return fmt.Errorf("failed to disable admin user | %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	if _, err = tx.Exec(ctx, `
+update moving.admin_refresh_tokens
+set revoked_at = now()
+where user_id = $1 and revoked_at is null
+`, id); err != nil {
+		return fmt.Errorf("failed to revoke refresh tokens | %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit tx | %w", err)
+	}
+
+	return nil
+}
+
+func (p *Postgres) CreateRefreshToken(ctx context.Context, userID uint64, tokenHash string, expiresAt time.Time) error {
+	if _, err := p.pool.Exec(ctx, `
+insert into moving.admin_refresh_tokens (user_id, token_hash, expires_at)
+values ($1, $2, $3)
+`, userID, tokenHash, expiresAt); err != nil {
+		return fmt.Errorf("failed to insert refresh token | %w", err)
+	}
+
+	return nil
+}
+
+// RotateRefreshToken revokes the refresh token and issues the new one to the same user.
+// A token used for the second time may be stolen, every token of its user is revoked then.
+func (p *Postgres) RotateRefreshToken(
+	ctx context.Context, tokenHash, newTokenHash string, expiresAt time.Time,
+) (*User, error) {
+	tx, err := p.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin tx | %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	var (
+		userID           uint64
+		revoked, expired bool
+	)
+	if err = tx.QueryRow(ctx, `
+select user_id, revoked_at is not null, expires_at <= now()
+from moving.admin_refresh_tokens
+where token_hash = $1
+for update
+`, tokenHash).Scan(&userID, &revoked, &expired); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrInvalidRefreshToken
+		}
+
+		return nil, fmt.Errorf("failed to get refresh token | %w", err)
+	}
+
+	if expired && !revoked {
+		return nil, ErrInvalidRefreshToken
+	}
+
+	if _, err = tx.Exec(ctx, `
+update moving.admin_refresh_tokens
+set revoked_at = now()
+where (token_hash = $1 or ($2 and user_id = $3)) and revoked_at is null
+`, tokenHash, revoked, userID); err != nil {
+		return nil, fmt.Errorf("failed to revoke refresh token | %w", err)
+	}
+
+	if revoked {
+		if err = tx.Commit(ctx); err != nil {
+			return nil, fmt.Errorf("failed to commit tx | %w", err)
+		}
+
+		return nil, ErrInvalidRefreshToken
+	}
+
+	user, err := scanUser(tx.QueryRow(ctx, `
+select `+userColumns+`
+from moving.admin_users u
+where u.id = $1 and u.disabled_at is null
+`, userID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrInvalidRefreshToken
+		}
+
+		return nil, fmt.Errorf("failed to get admin user | %w", err)
+	}
+
+	if _, err = tx.Exec(ctx, `
+insert into moving.admin_refresh_tokens (user_id, token_hash, expires_at)
+values ($1, $2, $3)
+`, userID, newTokenHash, expiresAt); err != nil {
+		return nil, fmt.Errorf("failed to insert refresh token | %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit tx | %w", err)
+	}
+
+	return user, nil
+}
+
+func (p *Postgres) RevokeRefreshToken(ctx context.Context, tokenHash string) error {
+	if _, err := p.pool.Exec(ctx, `
+update moving.admin_refresh_tokens
+set revoked_at = now()
+where token_hash = $1 and revoked_at is null
+`, tokenHash); err != nil {
+		return fmt.Errorf("failed to revoke refresh token | %w", err)
+	}
+
+	return nil
+}
+
+func scanUser(row pgx.Row) (*User, error) {
+	var user User
+	if err := row.Scan(
+		&user.ID, &user.TenantID, &user.Email, &user.Name, &user.PasswordHash, &user.Roles, &user.CreatedAt, &user.DisabledAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+type CreateUserRequest struct {
+	TenantID     *uint64
+	Email        string
+	Name         string
+	PasswordHash string
+	Roles        []string
+}
+
+type User struct {
+	ID           uint64
+	TenantID     *uint64
+	Email        string
+	Name         string
+	PasswordHash string
+	Roles        []string
+	CreatedAt    time.Time
+	DisabledAt   *time.Time
+}
@@ -0,0 +1,333 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	DeliveryStatusPending   = "pending"
+	DeliveryStatusDelivered = "delivered"
+	DeliveryStatusFailed    = "failed"
+)
+
+var ErrNotFound = errors.New("not found")
+
+type Postgres struct {
+	pool *pgxpool.Pool
+}
+
+func NewPostgres(pool *pgxpool.Pool) *Postgres {
+	return &Postgres{pool: pool}
+}
+
+func (p *Postgres) CreateSubscription(
+	ctx context.Context, tenantID uint64, url string, eventTypes []string, secret string,
+) (*Subscription, error) {
+	query := `
+insert into moving.webhook_subscriptions (url, event_types, secret, tenant_id)
+values ($1, $2, $3, $4)
+returning id, url, event_types, secret, created_at
+`
+
+	subscription, err := scanSubscription(p.pool.QueryRow(ctx, query, url, eventTypes, secret, tenantID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert webhook subscription | %w", err)
+	}
+
+	return subscription, nil
+}
+
+func (p *Postgres) Subscriptions(ctx context.Context, tenantID uint64) ([]*Subscription, error) {
+	query := `
+select id, url, event_types, secret, created_at
+from moving.webhook_subscriptions
+where tenant_id = $1 and deleted_at is null
+order by id
+`
+
+	rows, err := p.pool.Query(ctx, query, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query webhook subscriptions | %w", err)
+	}
+	defer rows.Close()
+
+	var subscriptions []*Subscription
+
+	for rows.Next() {
+		subscription, scanErr := scanSubscription(rows)
+		if scanErr != nil {
+			return nil, fmt.Errorf("failed scan webhook subscription | %w", scanErr)
+		}
+
+		subscriptions = append(subscriptions, subscription)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed get webhook subscriptions | %w", err)
+	}
+
+	if len(subscriptions) == 0 {
+		return nil, ErrNotFound
+	}
+
+	return subscriptions, nil
+}
+
+func scanSubscription(row pgx.Row) (*Subscription, error) {
+	var subscription Subscription
+
+	if err := row.Scan(
+		&subscription.ID, &subscription.URL, &subscription.EventTypes, &subscription.Secret, &subscription.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return &subscription, nil
+}
+
+// DeleteSubscription keeps the row for the delivery log, pending deliveries are cancelled.
+func (p *Postgres) DeleteSubscription(ctx context.Context, tenantID, id uint64) error {
+	tx, err := p.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin tx | %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	tag, err := tx.Exec(ctx, `
+update moving.webhook_subscriptions
+set deleted_at = now()
+where id = $1 and tenant_id = $2 and deleted_at is null
+`, id, tenantID)
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook subscription | %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	if _, err = tx.Exec(ctx, `
+update moving.webhook_deliveries
+set status = 'failed', last_error = 'subscription deleted'
+where subscription_id = $1 and status = 'pending'
+`, id); err != nil {
+		return fmt.Errorf("failed to cancel webhook deliveries | %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit tx | %w", err)
+	}
+
+	return nil
+}
+
+// Fanout creates a delivery for every subscription of the tenant to the event type.
+// It is safe to call again for the same outbox event.
+func (p *Postgres) Fanout(
+	ctx context.Context, tenantID, outboxEventID uint64, eventType string, orderID uint64, payload []byte,
+) error {
+	query := `
+insert into moving.webhook_deliveries (subscription_id, outbox_event_id, event_type, order_id, payload)
+select id, $1, $2, $3, $4
+from moving.webhook_subscriptions
+where tenant_id = $5 and deleted_at is null and $2 = any(event_types)
+on conflict (subscription_id, outbox_event_id, event_type) do nothing
+`
+
+	if _, err := p.pool.Exec(ctx, query, outboxEventID, eventType, orderID, payload, tenantID); err != nil {
+		return fmt.Errorf("failed to fan out webhook deliveries | %w", err)
+	}
+
+	return nil
+}
+
+// ClaimDeliveries takes due pending deliveries and hides them from other replicas until the lease expires.
+func (p *Postgres) ClaimDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*Delivery, error) {
+	query := `
+update moving.webhook_deliveries d
+set attempts = d.attempts + 1, next_attempt_at = $2
+from moving.webhook_subscriptions s
+where s.id = d.subscription_id and d.id in (
+	select id
+	from moving.webhook_deliveries
+	where status = 'pending' and next_attempt_at <= $3
+	order by id
+	limit $1
+	for update skip locked
+)
+returning ` + deliveryColumns + `, s.url, s.secret
+`
+
+	now := time.Now().UTC()
+
+	rows, err := p.pool.Query(ctx, query, limit, now.Add(lease), now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim webhook deliveries | %w", err)
+	}
+	defer rows.Close()
+
+	var deliveries []*Delivery
+
+	for rows.Next() {
+		var delivery Delivery
+		if err = rows.Scan(append(deliveryDest(&delivery), &delivery.URL, &delivery.Secret)...); err != nil {
+			return nil, fmt.Errorf("failed scan webhook delivery | %w", err)
+		}
+
+		deliveries = append(deliveries, &delivery)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed get webhook deliveries | %w", err)
+	}
+
+	return deliveries, nil
+}
+
+func (p *Postgres) MarkDelivered(ctx context.Context, id uint64, responseStatus int) error {
+	query := `
+update moving.webhook_deliveries
+set status = 'delivered', response_status = $2, last_error = null, delivered_at = now()
+where id = $1
+`
+
+	if _, err := p.pool.Exec(ctx, query, id, responseStatus); err != nil {
+		return fmt.Errorf("failed to mark webhook delivered | %w", err)
+	}
+
+	return nil
+}
+
+func (p *Postgres) Retry(
+	ctx context.Context, id uint64, nextAttemptAt time.Time, responseStatus *int, lastError string,
+) error {
+	query := `
+update moving.webhook_deliveries
+set next_attempt_at = $2, response_status = $3, last_error = $4
+where id = $1
+`
+
+	if _, err := p.pool.Exec(ctx, query, id, nextAttemptAt.UTC(), responseStatus, lastError); err != nil {
+		return fmt.Errorf("failed to schedule webhook retry | %w", err)
+	}
+
+	return nil
+}
+
+func (p *Postgres) MarkFailed(ctx context.Context, id uint64, responseStatus *int, lastError string) error {
+	query := `
+update moving.webhook_deliveries
+set status = 'failed', response_status = $2, last_error = $3
+where id = $1
+`
+
+	if _, err := p.pool.Exec(ctx, query, id, responseStatus, lastError); err != nil {
+		return fmt.Errorf("failed to mark webhook failed | %w", err)
+	}
+
+	return nil
+}
+
+// Deliveries returns the latest deliveries first.
+func (p *Postgres) Deliveries(ctx context.Context, filter *DeliveriesFilter) ([]*Delivery, error) {
+	qb := squirrel.Select(deliveryColumns).
+		From("moving.webhook_deliveries d").
+		Join("moving.webhook_subscriptions s on s.id = d.subscription_id").
+		Where(squirrel.Eq{"s.tenant_id": filter.TenantID}).
+		OrderBy("d.id desc").
+		Limit(filter.Limit).
+		PlaceholderFormat(squirrel.Dollar)
+
+	if filter.SubscriptionID != nil {
+		qb = qb.Where(squirrel.Eq{"d.subscription_id": *filter.SubscriptionID})
+	}
+
+	if filter.Status != nil {
+		qb = qb.Where(squirrel.Eq{"d.status": *filter.Status})
+	}
+
+	query, args, err := qb.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build query | %w", err)
+	}
+
+	rows, err := p.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query webhook deliveries | %w", err)
+	}
+	defer rows.Close()
+
+	var deliveries []*Delivery
+
+	for rows.Next() {
+		var delivery Delivery
+		if err = rows.Scan(deliveryDest(&delivery)...); err != nil {
+			return nil, fmt.Errorf("failed scan webhook delivery | %w", err)
+		}
+
+		deliveries = append(deliveries, &delivery)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed get webhook deliveries | %w", err)
+	}
+
+	if len(deliveries) == 0 {
+		return nil, ErrNotFound
+	}
+
+	return deliveries, nil
+}
+
+// deliveryColumns are scanned by deliveryDest in the same order.
+const deliveryColumns = `d.id, d.subscription_id, d.event_type, d.order_id, d.payload, d.status::text, d.attempts,
+	d.response_status, d.last_error, d.next_attempt_at, d.created_at, d.delivered_at`
+
+func deliveryDest(delivery *Delivery) []any {
+	return []any{
+		&delivery.ID, &delivery.SubscriptionID, &delivery.EventType, &delivery.OrderID, &delivery.Payload,
+		&delivery.Status, &delivery.Attempts, &delivery.ResponseStatus, &delivery.LastError,
+		&delivery.NextAttemptAt, &delivery.CreatedAt, &delivery.DeliveredAt,
+	}
+}
+
+type Subscription struct {
+	ID         uint64
+	URL        string
+	EventTypes []string
+	Secret     string
+	CreatedAt  time.Time
+}
+
+type Delivery struct {
+	ID             uint64
+	SubscriptionID uint64
+	EventType      string
+	OrderID        uint64
+	Payload        []byte
+	Status         string
+	Attempts       int
+	ResponseStatus *int
+	LastError      *string
+	NextAttemptAt  time.Time
+	CreatedAt      time.Time
+	DeliveredAt    *time.Time
+
+	// URL and Secret are filled for claimed deliveries only.
+	URL    string
+	Secret string
+}
+
+type DeliveriesFilter struct {
+	TenantID       uint64
+	SubscriptionID *uint64
+	Status         *string
+	Limit          uint64
+}
@@ -0,0 +1,329 @@
+package apikeys
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ingvarmattis/moving/src/infra/identity"
+	"github.com/ingvarmattis/moving/src/infra/tenant"
+	repo "github.com/ingvarmattis/moving/src/repositories/apikeys"
+)
+
+// KeyPrefix tells api keys from access tokens.
+const KeyPrefix = "mk_"
+
+const (
+	keySize = 32
+	// visiblePrefixSize characters of the key after KeyPrefix are listed to recognize it.
+	visiblePrefixSize = 8
+)
+
+var (
+	ErrNotFound     = errors.New("not found")
+	ErrInvalidKey   = errors.New("api key is invalid, revoked or expired")
+	ErrScopeNotHeld = errors.New("the caller does not hold the scope")
+)
+
+type keysStorage interface {
+	CreateKey(ctx context.Context, req *repo.CreateKeyRequest) (*repo.Key, error)
+	Keys(ctx context.Context, tenantID uint64) ([]*repo.Key, error)
+	ActiveKey(ctx context.Context, tenantID, id uint64) (*repo.Key, error)
+	RevokeKey(ctx context.Context, tenantID, id uint64) error
+	RotateKey(ctx context.Context, id uint64, req *repo.CreateKeyRequest, oldExpiresAt time.Time) (*repo.Key, error)
+	UseKey(ctx context.Context, keyHash string) (*repo.Key, error)
+}
+
+type cachedKey struct {
+	key      Key
+	cachedAt time.Time
+}
+
+// Service verified keys are cached for cacheTTL, it is how long a revoked key may still work
+// and how often its last usage is recorded.
+type Service struct {
+	storage     keysStorage
+	cacheTTL    time.Duration
+	gracePeriod time.Duration
+
+	mu    sync.Mutex
+	cache map[string]cachedKey
+}
+
+func NewService(storage keysStorage, cacheTTL, gracePeriod time.Duration) *Service {
+	return &Service{
+		storage:     storage,
+		cacheTTL:    cacheTTL,
+		gracePeriod: gracePeriod,
+		cache:       make(map[string]cachedKey),
+	}
+}
+
+// IsKey tells if the bearer token is an api key.
+func IsKey(token string) bool {
+	return strings.HasPrefix(token, KeyPrefix)
+}
+
+// CreateKey returns the key of the tenant of the call with its secret, the secret is not stored
+// and cannot be shown again. The caller may only grant the scopes it holds.
+func (s *Service) CreateKey(ctx context.Context, req *CreateKeyRequest) (*CreatedKey, error) {
+	if err := checkScopesHeld(ctx, req.Scopes); err != nil {
+		return nil, err
+	}
+
+	secret, createReq, err := newKey(ctx, req.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	createReq.Name = req.Name
+	createReq.Scopes = scopeNames(req.Scopes)
+
+	key, err := s.storage.CreateKey(ctx, createReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create api key | %w", err)
+	}
+
+	return &CreatedKey{Key: toKey(key), Secret: secret}, nil
+}
+
+func (s *Service) Keys(ctx context.Context) ([]Key, error) {
+	tenantID, err := tenant.ID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := s.storage.Keys(ctx, tenantID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get api keys | %w", err)
+	}
+
+	result := make([]Key, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, toKey(key))
+	}
+
+	return result, nil
+}
+
+// RevokeKey stops the key at once on this replica, other replicas drop it from their cache within cacheTTL.
+func (s *Service) RevokeKey(ctx context.Context, id uint64) error {
+	tenantID, err := tenant.ID(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err = s.storage.RevokeKey(ctx, tenantID, id); err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return ErrNotFound
+		}
+
+		return fmt.Errorf("failed to revoke api key | %w", err)
+	}
+
+	s.forget(id)
+
+	return nil
+}
+
+// RotateKey issues a new key with the same name and scopes, the old one keeps working for the grace period.
+// The caller must hold every scope of the key, scopes of a key never change, so they are checked up front.
+func (s *Service) RotateKey(ctx context.Context, id uint64, req *RotateKeyRequest) (*CreatedKey, error) {
+	secret, createReq, err := newKey(ctx, req.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := s.storage.ActiveKey(ctx, createReq.TenantID, id)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get api key | %w", err)
+	}
+
+	if err = checkScopesHeld(ctx, toKey(current).Scopes); err != nil {
+		return nil, err
+	}
+
+	gracePeriod := s.gracePeriod
+	if req.GracePeriod != nil {
+		gracePeriod = *req.GracePeriod
+	}
+
+	key, err := s.storage.RotateKey(ctx, id, createReq, time.Now().Add(gracePeriod))
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+
+		return nil, fmt.Errorf("failed to rotate api key | %w", err)
+	}
+
+	s.forget(id)
+
+	return &CreatedKey{Key: toKey(key), Secret: secret}, nil
+}
+
+// Verify returns the active key of the secret.
+func (s *Service) Verify(ctx context.Context, secret string) (*Key, error) {
+	keyHash := hashKey(secret)
+	now := time.Now()
+
+	s.mu.Lock()
+	cached, ok := s.cache[keyHash]
+	s.mu.Unlock()
+
+	if ok && now.Sub(cached.cachedAt) < s.cacheTTL {
+		if cached.key.ExpiresAt != nil && !now.Before(*cached.key.ExpiresAt) {
+			return nil, ErrInvalidKey
+		}
+
+		return &cached.key, nil
+	}
+
+	key, err := s.storage.UseKey(ctx, keyHash)
+	if err != nil {
+		s.mu.Lock()
+		delete(s.cache, keyHash)
+		s.mu.Unlock()
+
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, ErrInvalidKey
+		}
+
+		return nil, fmt.Errorf("failed to verify api key | %w", err)
+	}
+
+	result := toKey(key)
+
+	s.mu.Lock()
+	s.cache[keyHash] = cachedKey{key: result, cachedAt: now}
+	s.mu.Unlock()
+
+	return &result, nil
+}
+
+func (s *Service) forget(id uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for hash, cached := range s.cache {
+		if cached.key.ID == id {
+			delete(s.cache, hash)
+		}
+	}
+}
+
+// newKey returns the secret given to the client and the request storing its hash.
+func newKey(ctx context.Context, expiresAt *time.Time) (string, *repo.CreateKeyRequest, error) {
+	tenantID, err := tenant.ID(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+
+	random := make([]byte, keySize)
+	if _, err = rand.Read(random); err != nil {
+		return "", nil, fmt.Errorf("failed to generate api key | %w", err)
+	}
+
+	secret := KeyPrefix + base64.RawURLEncoding.EncodeToString(random)
+
+	return secret, &repo.CreateKeyRequest{
+		TenantID:  tenantID,
+		Prefix:    secret[:len(KeyPrefix)+visiblePrefixSize],
+		KeyHash:   hashKey(secret),
+		ExpiresAt: expiresAt,
+		CreatedBy: identity.UserID(ctx),
+	}, nil
+}
+
+// checkScopesHeld fails unless the caller holds every scope, so a key never has more rights than its creator.
+func checkScopesHeld(ctx context.Context, scopes []identity.Scope) error {
+	caller, ok := identity.FromContext(ctx)
+
+	for _, scope := range scopes {
+		if !ok || !caller.HasScope(scope) {
+			return fmt.Errorf("%w %s", ErrScopeNotHeld, scope)
+		}
+	}
+
+	return nil
+}
+
+func hashKey(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+
+	return hex.EncodeToString(sum[:])
+}
+
+func scopeNames(scopes []identity.Scope) []string {
+	names := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		names = append(names, string(scope))
+	}
+
+	return names
+}
+
+func toKey(key *repo.Key) Key {
+	scopes := make([]identity.Scope, 0, len(key.Scopes))
+	for _, scope := range key.Scopes {
+		scopes = append(scopes, identity.Scope(scope))
+	}
+
+	return Key{
+		ID:         key.ID,
+		TenantID:   key.TenantID,
+		Name:       key.Name,
+		Prefix:     key.Prefix,
+		Scopes:     scopes,
+		ExpiresAt:  key.ExpiresAt,
+		LastUsedAt: key.LastUsedAt,
+		CreatedBy:  key.CreatedBy,
+		CreatedAt:  key.CreatedAt,
+		RevokedAt:  key.RevokedAt,
+	}
+}
+
+type CreateKeyRequest struct {
+	Name      string
+	Scopes    []identity.Scope
+	ExpiresAt *time.Time
+}
+
+// RotateKeyRequest ExpiresAt is of the new key, the old key expires after GracePeriod or the default one.
+type RotateKeyRequest struct {
+	ExpiresAt   *time.Time
+	GracePeriod *time.Duration
+}
+
+type Key struct {
+	ID         uint64
+	TenantID   uint64
+	Name       string
+	Prefix     string
+	Scopes     []identity.Scope
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	CreatedBy  *uint64
+	CreatedAt  time.Time
+	RevokedAt  *time.Time
+}
+
+type CreatedKey struct {
+	Key    Key
+	Secret string
+}
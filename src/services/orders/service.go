@@ -4,8 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
+	"go.uber.org/zap"
+
+	"github.com/ingvarmattis/moving/src/infra/identity"
+	"github.com/ingvarmattis/moving/src/infra/tenant"
 	"github.com/ingvarmattis/moving/src/infra/utils"
 	repo "github.com/ingvarmattis/moving/src/repositories/orders"
 )
@@ -16,21 +21,46 @@ var ErrNotFound = errors.New("not found")
 //go:generate mockgen -source=service.go -destination=mocks/mocks.go -package=mocks
 type ordersStorage interface {
 	CreateOrder(ctx context.Context, req *repo.CreateOrderRequest) (*repo.Order, error)
-	Orders(ctx context.Context, filter *repo.Filter) ([]*repo.Order, error)
-	OrderByID(ctx context.Context, id uint64) (*repo.Order, error)
-	UpdateOrder(ctx context.Context, req *repo.UpdateOrderRequest) error
+	Orders(ctx context.Context, tenantID uint64, filter *repo.Filter) ([]*repo.Order, error)
+	OrderByID(ctx context.Context, tenantID, id uint64) (*repo.Order, error)
+	UpdateOrder(ctx context.Context, req *repo.UpdateOrderRequest) (*repo.UpdatedOrder, error)
+	OrdersStats(
+		ctx context.Context, tenantID uint64, filter *repo.Filter, granularity repo.StatsGranularity,
+	) (*repo.Stats, error)
+	ListenOrders(ctx context.Context, handle func(notification *repo.OrderNotification)) error
+}
+
+type ordersMetrics interface {
+	OrderCreated(propertySize, source, protocol string)
+	OrderStatusChanged(from, to string)
 }
 
 type Service struct {
 	ordersStorage ordersStorage
+	ordersMetrics ordersMetrics
+	logger        *zap.Logger
+
+	watchers *watchers
 }
 
-func NewService(ordersStorage ordersStorage) *Service {
-	return &Service{ordersStorage: ordersStorage}
+func NewService(ordersStorage ordersStorage, ordersMetrics ordersMetrics, logger *zap.Logger) *Service {
+	return &Service{
+		ordersStorage: ordersStorage,
+		ordersMetrics: ordersMetrics,
+		logger:        logger,
+		watchers:      &watchers{items: make(map[*watcher]struct{})},
+	}
 }
 
+// CreateOrder adds the order to the tenant of the call.
 func (s *Service) CreateOrder(ctx context.Context, req *CreateOrderRequest) (*Order, error) {
+	tenantID, err := tenant.ID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	repoReq := &repo.CreateOrderRequest{
+		TenantID:       tenantID,
 		PropertySize:   repo.PropertySize(req.PropertySize),
 		MoveDate:       req.MoveDate,
 		Name:           req.Name,
@@ -39,6 +69,13 @@ func (s *Service) CreateOrder(ctx context.Context, req *CreateOrderRequest) (*Or
 		MoveFrom:       req.MoveFrom,
 		MoveTo:         req.MoveTo,
 		AdditionalInfo: req.AdditionalInfo,
+		Source:         repo.LeadSource(req.Source),
+		UTMSource:      req.UTMSource,
+		UTMMedium:      req.UTMMedium,
+		UTMCampaign:    req.UTMCampaign,
+		Referrer:       req.Referrer,
+		LandingPage:    req.LandingPage,
+		SpamReason:     req.SpamReason,
 	}
 
 	order, err := s.ordersStorage.CreateOrder(ctx, repoReq)
@@ -46,8 +83,13 @@ func (s *Service) CreateOrder(ctx context.Context, req *CreateOrderRequest) (*Or
 		return nil, fmt.Errorf("failed to create order | %w", err)
 	}
 
+	if order.SpamReason == nil {
+		s.ordersMetrics.OrderCreated(order.PropertySize.String(), order.Source.String(), utils.RequestProtocol(ctx))
+	}
+
 	return &Order{
 		ID:             order.ID,
+		TenantID:       order.TenantID,
 		PropertySize:   PropertySize(order.PropertySize),
 		OrderStatus:    OrderStatus(order.OrderStatus),
 		MoveDate:       order.MoveDate,
@@ -57,6 +99,16 @@ func (s *Service) CreateOrder(ctx context.Context, req *CreateOrderRequest) (*Or
 		MoveFrom:       order.MoveFrom,
 		MoveTo:         order.MoveTo,
 		AdditionalInfo: order.AdditionalInfo,
+		ReviewSecret:   order.ReviewSecret,
+		Source:         LeadSource(order.Source),
+		UTMSource:      order.UTMSource,
+		UTMMedium:      order.UTMMedium,
+		UTMCampaign:    order.UTMCampaign,
+		Referrer:       order.Referrer,
+		LandingPage:    order.LandingPage,
+		SpamReason:     order.SpamReason,
+		ArrivalWindow:  ArrivalWindow(order.ArrivalWindow),
+		UpdatedBy:      order.UpdatedBy,
 		CreatedAt:      order.CreatedAt,
 		UpdatedAt:      order.UpdatedAt,
 	}, nil
@@ -93,25 +145,43 @@ func normalizeFilter(filter *Filter) *repo.Filter {
 		propertySize = &ps
 	}
 
-	if orderStatus == nil && propertySize == nil && createdFrom == nil &&
-		createdTo == nil && moveDateFrom == nil && moveDateTo == nil {
+	var source *repo.LeadSource
+	if filter.Source != nil {
+		ls := repo.LeadSource(*filter.Source)
+		source = &ls
+	}
+
+	var search *string
+	if filter.Search != nil && strings.TrimSpace(*filter.Search) != "" {
+		trimmed := strings.TrimSpace(*filter.Search)
+		search = &trimmed
+	}
+
+	if orderStatus == nil && propertySize == nil && source == nil && !filter.Spam && search == nil &&
+		createdFrom == nil && createdTo == nil && moveDateFrom == nil && moveDateTo == nil {
 		return nil
 	}
 
 	return &repo.Filter{
 		OrderStatus:  orderStatus,
 		PropertySize: propertySize,
+		Source:       source,
+		Spam:         filter.Spam,
 		CreatedFrom:  createdFrom,
 		CreatedTo:    createdTo,
 		MoveDateFrom: moveDateFrom,
 		MoveDateTo:   moveDateTo,
+		Search:       search,
 	}
 }
 
 func (s *Service) Orders(ctx context.Context, filter *Filter) ([]*Order, error) {
-	repoFilter := normalizeFilter(filter)
+	tenantID, err := tenant.ID(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-	repoOrders, err := s.ordersStorage.Orders(ctx, repoFilter)
+	repoOrders, err := s.ordersStorage.Orders(ctx, tenantID, normalizeFilter(filter))
 	if err != nil {
 		if errors.Is(err, repo.ErrNotFound) {
 			return nil, ErrNotFound
@@ -125,6 +195,7 @@ func (s *Service) Orders(ctx context.Context, filter *Filter) ([]*Order, error)
 	for _, repoOrder := range repoOrders {
 		orders = append(orders, &Order{
 			ID:             repoOrder.ID,
+			TenantID:       repoOrder.TenantID,
 			PropertySize:   PropertySize(repoOrder.PropertySize),
 			OrderStatus:    OrderStatus(repoOrder.OrderStatus),
 			MoveDate:       repoOrder.MoveDate,
@@ -134,6 +205,16 @@ func (s *Service) Orders(ctx context.Context, filter *Filter) ([]*Order, error)
 			MoveFrom:       repoOrder.MoveFrom,
 			MoveTo:         repoOrder.MoveTo,
 			AdditionalInfo: repoOrder.AdditionalInfo,
+			ReviewSecret:   repoOrder.ReviewSecret,
+			Source:         LeadSource(repoOrder.Source),
+			UTMSource:      repoOrder.UTMSource,
+			UTMMedium:      repoOrder.UTMMedium,
+			UTMCampaign:    repoOrder.UTMCampaign,
+			Referrer:       repoOrder.Referrer,
+			LandingPage:    repoOrder.LandingPage,
+			SpamReason:     repoOrder.SpamReason,
+			ArrivalWindow:  ArrivalWindow(repoOrder.ArrivalWindow),
+			UpdatedBy:      repoOrder.UpdatedBy,
 			CreatedAt:      repoOrder.CreatedAt,
 			UpdatedAt:      repoOrder.UpdatedAt,
 		})
@@ -143,7 +224,12 @@ func (s *Service) Orders(ctx context.Context, filter *Filter) ([]*Order, error)
 }
 
 func (s *Service) OrderByID(ctx context.Context, id uint64) (*Order, error) {
-	order, err := s.ordersStorage.OrderByID(ctx, id)
+	tenantID, err := tenant.ID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := s.ordersStorage.OrderByID(ctx, tenantID, id)
 	if err != nil {
 		if errors.Is(err, repo.ErrNotFound) {
 			return nil, ErrNotFound
@@ -154,6 +240,7 @@ func (s *Service) OrderByID(ctx context.Context, id uint64) (*Order, error) {
 
 	return &Order{
 		ID:             order.ID,
+		TenantID:       order.TenantID,
 		PropertySize:   PropertySize(order.PropertySize),
 		OrderStatus:    OrderStatus(order.OrderStatus),
 		MoveDate:       order.MoveDate,
@@ -163,14 +250,30 @@ func (s *Service) OrderByID(ctx context.Context, id uint64) (*Order, error) {
 		MoveFrom:       order.MoveFrom,
 		MoveTo:         order.MoveTo,
 		AdditionalInfo: order.AdditionalInfo,
+		ReviewSecret:   order.ReviewSecret,
+		Source:         LeadSource(order.Source),
+		UTMSource:      order.UTMSource,
+		UTMMedium:      order.UTMMedium,
+		UTMCampaign:    order.UTMCampaign,
+		Referrer:       order.Referrer,
+		LandingPage:    order.LandingPage,
+		SpamReason:     order.SpamReason,
+		ArrivalWindow:  ArrivalWindow(order.ArrivalWindow),
+		UpdatedBy:      order.UpdatedBy,
 		CreatedAt:      order.CreatedAt,
 		UpdatedAt:      order.UpdatedAt,
 	}, nil
 }
 
 func (s *Service) UpdateOrder(ctx context.Context, req *UpdateOrderRequest) error {
+	tenantID, err := tenant.ID(ctx)
+	if err != nil {
+		return err
+	}
+
 	repoReq := &repo.UpdateOrderRequest{
 		ID:             req.ID,
+		TenantID:       tenantID,
 		MoveDate:       req.MoveDate,
 		Name:           req.Name,
 		Email:          req.Email,
@@ -178,6 +281,7 @@ func (s *Service) UpdateOrder(ctx context.Context, req *UpdateOrderRequest) erro
 		MoveFrom:       req.MoveFrom,
 		MoveTo:         req.MoveTo,
 		AdditionalInfo: req.AdditionalInfo,
+		UpdatedBy:      identity.UserID(ctx),
 	}
 
 	if req.PropertySize != nil {
@@ -188,13 +292,76 @@ func (s *Service) UpdateOrder(ctx context.Context, req *UpdateOrderRequest) erro
 		repoReq.OrderStatus = utils.PtrIfNotZero(repo.OrderStatus(*req.OrderStatus))
 	}
 
-	if err := s.ordersStorage.UpdateOrder(ctx, repoReq); err != nil {
+	if req.ArrivalWindow != nil {
+		repoReq.ArrivalWindow = utils.PtrIfNotZero(repo.ArrivalWindow(*req.ArrivalWindow))
+	}
+
+	updated, err := s.ordersStorage.UpdateOrder(ctx, repoReq)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return ErrNotFound
+		}
+
 		return fmt.Errorf("failed to update order | %w", err)
 	}
 
+	if updated.PreviousStatus != updated.Order.OrderStatus {
+		s.ordersMetrics.OrderStatusChanged(updated.PreviousStatus.String(), updated.Order.OrderStatus.String())
+	}
+
 	return nil
 }
 
+// OrdersStats returns aggregated order counts and the conversion rate from created to done.
+func (s *Service) OrdersStats(ctx context.Context, filter *Filter, granularity StatsGranularity) (*Stats, error) {
+	tenantID, err := tenant.ID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	repoStats, err := s.ordersStorage.OrdersStats(
+		ctx, tenantID, normalizeFilter(filter), repo.StatsGranularity(granularity),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get orders stats | %w", err)
+	}
+
+	stats := &Stats{
+		Total:          repoStats.Total,
+		ByOrderStatus:  make(map[OrderStatus]uint64, len(repoStats.ByOrderStatus)),
+		ByPropertySize: make(map[PropertySize]uint64, len(repoStats.ByPropertySize)),
+		BySource:       make(map[LeadSource]uint64, len(repoStats.BySource)),
+		ByCreatedAt:    make([]PeriodCount, 0, len(repoStats.ByCreatedAt)),
+		ByMoveDate:     make([]PeriodCount, 0, len(repoStats.ByMoveDate)),
+	}
+
+	for status, count := range repoStats.ByOrderStatus {
+		stats.ByOrderStatus[OrderStatus(status)] = count
+	}
+
+	for propertySize, count := range repoStats.ByPropertySize {
+		stats.ByPropertySize[PropertySize(propertySize)] = count
+	}
+
+	for source, count := range repoStats.BySource {
+		stats.BySource[LeadSource(source)] = count
+	}
+
+	for _, count := range repoStats.ByCreatedAt {
+		stats.ByCreatedAt = append(stats.ByCreatedAt, PeriodCount(count))
+	}
+
+	for _, count := range repoStats.ByMoveDate {
+		stats.ByMoveDate = append(stats.ByMoveDate, PeriodCount(count))
+	}
+
+	if stats.Total > 0 {
+		stats.ConversionRate = float64(stats.ByOrderStatus[OrderStatusDone]) / float64(stats.Total)
+	}
+
+	return stats, nil
+}
+
 type PropertySize int8
 
 const (
@@ -207,6 +374,28 @@ const (
 	PropertySizeCommercial
 )
 
+type LeadSource int8
+
+const (
+	LeadSourceUnknown LeadSource = iota
+	LeadSourceWebsite
+	LeadSourceYelp
+	LeadSourceGoogleAds
+	LeadSourceFacebookAds
+	LeadSourceReferral
+	LeadSourcePhone
+	LeadSourceOther
+)
+
+type ArrivalWindow int8
+
+const (
+	ArrivalWindowUnknown ArrivalWindow = iota
+	ArrivalWindowMorning
+	ArrivalWindowMidday
+	ArrivalWindowAfternoon
+)
+
 type OrderStatus int8
 
 const (
@@ -226,10 +415,18 @@ type CreateOrderRequest struct {
 	MoveFrom       string
 	MoveTo         string
 	AdditionalInfo *string
+	Source         LeadSource
+	UTMSource      *string
+	UTMMedium      *string
+	UTMCampaign    *string
+	Referrer       *string
+	LandingPage    *string
+	SpamReason     *string
 }
 
 type Order struct {
 	ID             uint64
+	TenantID       uint64
 	PropertySize   PropertySize
 	OrderStatus    OrderStatus
 	MoveDate       time.Time
@@ -239,6 +436,16 @@ type Order struct {
 	MoveFrom       string
 	MoveTo         string
 	AdditionalInfo *string
+	ReviewSecret   string
+	Source         LeadSource
+	UTMSource      *string
+	UTMMedium      *string
+	UTMCampaign    *string
+	Referrer       *string
+	LandingPage    *string
+	SpamReason     *string
+	ArrivalWindow  ArrivalWindow
+	UpdatedBy      *uint64
 	CreatedAt      time.Time
 	UpdatedAt      time.Time
 }
@@ -254,13 +461,41 @@ type UpdateOrderRequest struct {
 	MoveFrom       *string
 	MoveTo         *string
 	AdditionalInfo *string
+	ArrivalWindow  *ArrivalWindow
 }
 
 type Filter struct {
 	OrderStatus  *OrderStatus
 	PropertySize *PropertySize
+	Source       *LeadSource
+	Spam         bool
 	CreatedFrom  *time.Time
 	CreatedTo    *time.Time
 	MoveDateFrom *time.Time
 	MoveDateTo   *time.Time
+	Search       *string
+}
+
+type StatsGranularity int8
+
+const (
+	StatsGranularityUnknown StatsGranularity = iota
+	StatsGranularityDay
+	StatsGranularityWeek
+	StatsGranularityMonth
+)
+
+type Stats struct {
+	Total          uint64
+	ByOrderStatus  map[OrderStatus]uint64
+	ByPropertySize map[PropertySize]uint64
+	BySource       map[LeadSource]uint64
+	ByCreatedAt    []PeriodCount
+	ByMoveDate     []PeriodCount
+	ConversionRate float64
+}
+
+type PeriodCount struct {
+	PeriodStart time.Time
+	Count       uint64
 }
@@ -0,0 +1,257 @@
+package orders
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/ingvarmattis/moving/src/infra/tenant"
+	repo "github.com/ingvarmattis/moving/src/repositories/orders"
+)
+
+const (
+	// watcherBuffer changes may wait for a slow watcher, then it is interrupted.
+	watcherBuffer = 64
+
+	listenRetryDelay = 5 * time.Second
+)
+
+// ErrWatchInterrupted means changes may have been missed, the watch should be started again to resync.
+var ErrWatchInterrupted = errors.New("orders watch interrupted, watch again to resync")
+
+type OrderChangeType int8
+
+const (
+	OrderChangeTypeUnknown OrderChangeType = iota
+	// OrderChangeTypeSnapshot is an order matching the filter when the watch started.
+	OrderChangeTypeSnapshot
+	// OrderChangeTypeSynced follows the snapshot, it has no order.
+	OrderChangeTypeSynced
+	OrderChangeTypeCreated
+	OrderChangeTypeUpdated
+	// OrderChangeTypeRemoved is an order which does not match the filter after an update anymore.
+	OrderChangeTypeRemoved
+)
+
+type OrderChange struct {
+	Type  OrderChangeType
+	Order *Order
+}
+
+type watchedChange struct {
+	eventType string
+	order     *repo.Order
+}
+
+type watcher struct {
+	changes     chan watchedChange
+	interrupted chan struct{}
+	once        sync.Once
+}
+
+func (w *watcher) interrupt() {
+	w.once.Do(func() { close(w.interrupted) })
+}
+
+// watchers fans order changes of one listening connection out to every watch of the replica.
+type watchers struct {
+	mu    sync.Mutex
+	items map[*watcher]struct{}
+}
+
+func (ws *watchers) add() *watcher {
+	w := &watcher{changes: make(chan watchedChange, watcherBuffer), interrupted: make(chan struct{})}
+
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+
+	ws.items[w] = struct{}{}
+
+	return w
+}
+
+func (ws *watchers) remove(w *watcher) {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+
+	delete(ws.items, w)
+}
+
+func (ws *watchers) empty() bool {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+
+	return len(ws.items) == 0
+}
+
+func (ws *watchers) broadcast(change watchedChange) {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+
+	for w := range ws.items {
+		select {
+		case w.changes <- change:
+		default:
+			w.interrupt()
+			delete(ws.items, w)
+		}
+	}
+}
+
+func (ws *watchers) interruptAll() {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+
+	for w := range ws.items {
+		w.interrupt()
+		delete(ws.items, w)
+	}
+}
+
+// RunWatchers listens to order changes of every replica until ctx is done.
+// Watches are interrupted when the listening connection breaks, as changes may be missed until it is restored.
+func (s *Service) RunWatchers(ctx context.Context) {
+	for {
+		err := s.ordersStorage.ListenOrders(ctx, func(notification *repo.OrderNotification) {
+			s.publish(ctx, notification)
+		})
+
+		s.watchers.interruptAll()
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		s.logger.Error("order changes listener failed", zap.Error(err))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(listenRetryDelay):
+		}
+	}
+}
+
+func (s *Service) publish(ctx context.Context, notification *repo.OrderNotification) {
+	if s.watchers.empty() {
+		return
+	}
+
+	order, err := s.ordersStorage.OrderByID(ctx, notification.TenantID, notification.ID)
+	if err != nil {
+		s.logger.Error("failed to get changed order", zap.Error(err), zap.Uint64("order_id", notification.ID))
+		s.watchers.interruptAll()
+
+		return
+	}
+
+	s.watchers.broadcast(watchedChange{eventType: notification.Type, order: order})
+}
+
+// WatchOrders sends orders of the tenant matching the filter, then their changes, until ctx is done or send fails.
+func (s *Service) WatchOrders(ctx context.Context, filter *Filter, send func(change *OrderChange) error) error {
+	tenantID, err := tenant.ID(ctx)
+	if err != nil {
+		return err
+	}
+
+	repoFilter := normalizeFilter(filter)
+
+	// the watcher is added before the snapshot is read, so no change is lost in between
+	w := s.watchers.add()
+	defer s.watchers.remove(w)
+
+	snapshot, err := s.ordersStorage.Orders(ctx, tenantID, repoFilter)
+	if err != nil && !errors.Is(err, repo.ErrNotFound) {
+		return fmt.Errorf("failed to get orders | %w", err)
+	}
+
+	visible := make(map[uint64]struct{}, len(snapshot))
+	for _, order := range snapshot {
+		visible[order.ID] = struct{}{}
+
+		if err = send(&OrderChange{Type: OrderChangeTypeSnapshot, Order: toOrder(order)}); err != nil {
+			return err
+		}
+	}
+
+	if err = send(&OrderChange{Type: OrderChangeTypeSynced}); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-w.interrupted:
+			return ErrWatchInterrupted
+		case change := <-w.changes:
+			if change.order.TenantID != tenantID {
+				continue
+			}
+
+			changeType := watchedChangeType(repoFilter, visible, change)
+			if changeType == OrderChangeTypeUnknown {
+				continue
+			}
+
+			if changeType == OrderChangeTypeRemoved {
+				delete(visible, change.order.ID)
+			} else {
+				visible[change.order.ID] = struct{}{}
+			}
+
+			if err = send(&OrderChange{Type: changeType, Order: toOrder(change.order)}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// watchedChangeType is unknown for changes the watch should not see.
+func watchedChangeType(filter *repo.Filter, visible map[uint64]struct{}, change watchedChange) OrderChangeType {
+	_, seen := visible[change.order.ID]
+
+	switch {
+	case !filter.Matches(change.order) && seen:
+		return OrderChangeTypeRemoved
+	case !filter.Matches(change.order):
+		return OrderChangeTypeUnknown
+	case change.eventType == repo.EventOrderCreated:
+		return OrderChangeTypeCreated
+	default:
+		return OrderChangeTypeUpdated
+	}
+}
+
+func toOrder(order *repo.Order) *Order {
+	return &Order{
+		ID:             order.ID,
+		TenantID:       order.TenantID,
+		PropertySize:   PropertySize(order.PropertySize),
+		OrderStatus:    OrderStatus(order.OrderStatus),
+		MoveDate:       order.MoveDate,
+		Name:           order.Name,
+		Email:          order.Email,
+		Phone:          order.Phone,
+		MoveFrom:       order.MoveFrom,
+		MoveTo:         order.MoveTo,
+		AdditionalInfo: order.AdditionalInfo,
+		ReviewSecret:   order.ReviewSecret,
+		Source:         LeadSource(order.Source),
+		UTMSource:      order.UTMSource,
+		UTMMedium:      order.UTMMedium,
+		UTMCampaign:    order.UTMCampaign,
+		Referrer:       order.Referrer,
+		LandingPage:    order.LandingPage,
+		SpamReason:     order.SpamReason,
+		ArrivalWindow:  ArrivalWindow(order.ArrivalWindow),
+		UpdatedBy:      order.UpdatedBy,
+		CreatedAt:      order.CreatedAt,
+		UpdatedAt:      order.UpdatedAt,
+	}
+}
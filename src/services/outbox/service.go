@@ -0,0 +1,238 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/ingvarmattis/moving/src/infra/utils"
+	repo "github.com/ingvarmattis/moving/src/repositories/outbox"
+)
+
+const (
+	DestinationTelegram      = "telegram"
+	DestinationCustomerEmail = "customer_email"
+	DestinationOfficeEmail   = "office_email"
+	DestinationCustomerSMS   = "customer_sms"
+	DestinationWebhooks      = "webhooks"
+)
+
+const (
+	ResultDelivered = "delivered"
+	ResultRetry     = "retry"
+	ResultDead      = "dead"
+)
+
+const purgeInterval = time.Hour
+
+type outboxStorage interface {
+	Claim(ctx context.Context, limit int, lease time.Duration) ([]*repo.Event, error)
+	MarkDelivered(ctx context.Context, id uint64) error
+	Retry(ctx context.Context, id uint64, nextAttemptAt time.Time, lastError string) error
+	MarkDead(ctx context.Context, id uint64, lastError string) error
+	PendingByDestination(ctx context.Context) (map[string]uint64, error)
+	Lag(ctx context.Context) (time.Duration, error)
+	PurgeDelivered(ctx context.Context, before time.Time) error
+}
+
+type outboxMetrics interface {
+	OutboxEvent(destination, result string, lag time.Duration)
+	OutboxPending(counts map[string]uint64)
+}
+
+// Handler delivers one event to its destination, an error schedules a retry.
+// Events are delivered at least once, so handlers should tolerate duplicates.
+type Handler func(ctx context.Context, event *Event) error
+
+type Options struct {
+	PollInterval time.Duration
+	BatchSize    int
+	Lease        time.Duration
+	MaxAttempts  int
+	BaseBackoff  time.Duration
+	MaxBackoff   time.Duration
+	Retention    time.Duration
+}
+
+type Dispatcher struct {
+	storage  outboxStorage
+	metrics  outboxMetrics
+	logger   *zap.Logger
+	handlers map[string]Handler
+	opts     Options
+
+	lastPurge time.Time
+}
+
+func NewDispatcher(storage outboxStorage, metrics outboxMetrics, logger *zap.Logger, opts Options) *Dispatcher {
+	return &Dispatcher{
+		storage:  storage,
+		metrics:  metrics,
+		logger:   logger,
+		handlers: make(map[string]Handler),
+		opts:     opts,
+	}
+}
+
+// Register must be called before Run.
+func (d *Dispatcher) Register(destination string, handler Handler) {
+	d.handlers[destination] = handler
+}
+
+// Run delivers pending events until ctx is done, events claimed by then are still delivered,
+// otherwise they would wait for the lease to expire.
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.opts.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		d.dispatch(ctx)
+		d.refresh(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Lag is how long the oldest due event waits for delivery, a growing lag means events are not delivered.
+func (d *Dispatcher) Lag(ctx context.Context) (time.Duration, error) {
+	return d.storage.Lag(ctx)
+}
+
+// dispatch drains due events batch by batch.
+func (d *Dispatcher) dispatch(ctx context.Context) {
+	for ctx.Err() == nil {
+		events, err := d.storage.Claim(ctx, d.opts.BatchSize, d.opts.Lease)
+		if err != nil {
+			if ctx.Err() == nil {
+				d.logger.Error("failed to claim outbox events", zap.Error(err))
+			}
+
+			return
+		}
+
+		for _, event := range events {
+			d.deliver(ctx, newEvent(event))
+		}
+
+		if len(events) < d.opts.BatchSize {
+			return
+		}
+	}
+}
+
+func (d *Dispatcher) deliver(ctx context.Context, event *Event) {
+	logger := d.logger.With(
+		zap.Uint64("event_id", event.ID),
+		zap.String("destination", event.Destination),
+		zap.String("event_type", event.Type),
+		zap.Int("attempt", event.Attempts),
+	)
+
+	// the event is delivered and its state is saved even if the dispatcher is stopping
+	storageCTX := context.WithoutCancel(ctx)
+
+	handler, ok := d.handlers[event.Destination]
+	if !ok {
+		d.dead(storageCTX, logger, event, fmt.Errorf("no handler for destination %q", event.Destination))
+		return
+	}
+
+	handlerCTX, cancel := context.WithTimeout(storageCTX, d.opts.Lease)
+	err := handler(handlerCTX, event)
+	cancel()
+
+	if err == nil {
+		if err = d.storage.MarkDelivered(storageCTX, event.ID); err != nil {
+			logger.Error("failed to mark outbox event delivered", zap.Error(err))
+		}
+
+		d.metrics.OutboxEvent(event.Destination, ResultDelivered, time.Since(event.CreatedAt))
+
+		return
+	}
+
+	if event.Attempts >= d.opts.MaxAttempts {
+		d.dead(storageCTX, logger, event, err)
+		return
+	}
+
+	logger.Warn("outbox event delivery failed, will retry", zap.Error(err))
+
+	nextAttemptAt := time.Now().Add(utils.Backoff(d.opts.BaseBackoff, d.opts.MaxBackoff, event.Attempts))
+
+	if err = d.storage.Retry(storageCTX, event.ID, nextAttemptAt, err.Error()); err != nil {
+		logger.Error("failed to schedule outbox event retry", zap.Error(err))
+	}
+
+	d.metrics.OutboxEvent(event.Destination, ResultRetry, time.Since(event.CreatedAt))
+}
+
+func (d *Dispatcher) dead(ctx context.Context, logger *zap.Logger, event *Event, cause error) {
+	logger.Error("outbox event moved to dead letters", zap.Error(cause))
+
+	if err := d.storage.MarkDead(ctx, event.ID, cause.Error()); err != nil {
+		logger.Error("failed to mark outbox event dead", zap.Error(err))
+	}
+
+	d.metrics.OutboxEvent(event.Destination, ResultDead, time.Since(event.CreatedAt))
+}
+
+// refresh updates the backlog metrics and purges delivered events from time to time.
+func (d *Dispatcher) refresh(ctx context.Context) {
+	counts, err := d.storage.PendingByDestination(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			d.logger.Error("failed to count pending outbox events", zap.Error(err))
+		}
+	} else {
+		for destination := range d.handlers {
+			if _, ok := counts[destination]; !ok {
+				counts[destination] = 0
+			}
+		}
+
+		d.metrics.OutboxPending(counts)
+	}
+
+	if time.Since(d.lastPurge) < purgeInterval {
+		return
+	}
+
+	if err = d.storage.PurgeDelivered(ctx, time.Now().Add(-d.opts.Retention)); err != nil {
+		if ctx.Err() == nil {
+			d.logger.Error("failed to purge outbox", zap.Error(err))
+		}
+
+		return
+	}
+
+	d.lastPurge = time.Now()
+}
+
+type Event struct {
+	ID          uint64
+	Destination string
+	Type        string
+	AggregateID uint64
+	Payload     []byte
+	Attempts    int
+	CreatedAt   time.Time
+}
+
+func newEvent(event *repo.Event) *Event {
+	return &Event{
+		ID:          event.ID,
+		Destination: event.Destination,
+		Type:        event.EventType,
+		AggregateID: event.AggregateID,
+		Payload:     event.Payload,
+		Attempts:    event.Attempts,
+		CreatedAt:   event.CreatedAt,
+	}
+}
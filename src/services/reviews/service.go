@@ -2,30 +2,51 @@ package reviews
 
 import (
 	"context"
+	"crypto/subtle"
 	"errors"
 	"fmt"
 
+	"github.com/ingvarmattis/moving/src/infra/tenant"
+	ordersrepo "github.com/ingvarmattis/moving/src/repositories/orders"
 	repo "github.com/ingvarmattis/moving/src/repositories/reviews"
 )
 
-var ErrNotFound = errors.New("not found")
+var (
+	ErrNotFound      = errors.New("not found")
+	ErrAlreadyExists = errors.New("review for this order already exists")
+	ErrInvalidSecret = errors.New("invalid order secret")
+	ErrOrderNotDone  = errors.New("order is not done")
+)
 
 //go:generate bash -c "mkdir -p mocks"
 //go:generate mockgen -source=service.go -destination=mocks/mocks.go -package=mocks
 type reviewStorage interface {
-	Reviews(ctx context.Context) ([]*repo.Review, error)
+	Reviews(ctx context.Context, tenantID uint64) ([]*repo.Review, error)
+	PendingReviews(ctx context.Context, tenantID uint64) ([]*repo.Review, error)
+	SubmitReview(ctx context.Context, req *repo.SubmitReviewRequest) (*repo.Review, error)
+	ModerateReview(ctx context.Context, tenantID, id uint64, status repo.ReviewStatus) error
+}
+
+type ordersStorage interface {
+	OrderByID(ctx context.Context, tenantID, id uint64) (*ordersrepo.Order, error)
 }
 
 type Service struct {
 	reviewStorage reviewStorage
+	ordersStorage ordersStorage
 }
 
-func NewService(reviewStorage reviewStorage) *Service {
-	return &Service{reviewStorage: reviewStorage}
+func NewService(reviewStorage reviewStorage, ordersStorage ordersStorage) *Service {
+	return &Service{reviewStorage: reviewStorage, ordersStorage: ordersStorage}
 }
 
 func (s *Service) Reviews(ctx context.Context) ([]Review, error) {
-	repoReviews, err := s.reviewStorage.Reviews(ctx)
+	tenantID, err := tenant.ID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	repoReviews, err := s.reviewStorage.Reviews(ctx, tenantID)
 	if err != nil {
 		if errors.Is(err, repo.ErrNotFound) {
 			return nil, ErrNotFound
@@ -34,25 +55,133 @@ func (s *Service) Reviews(ctx context.Context) ([]Review, error) {
 		return nil, fmt.Errorf("failed to get all reviews | %w", err)
 	}
 
+	return toReviews(repoReviews), nil
+}
+
+func (s *Service) PendingReviews(ctx context.Context) ([]Review, error) {
+	tenantID, err := tenant.ID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	repoReviews, err := s.reviewStorage.PendingReviews(ctx, tenantID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get pending reviews | %w", err)
+	}
+
+	return toReviews(repoReviews), nil
+}
+
+// SubmitReview puts a customer review into the moderation queue.
+// Only orders in done status can be reviewed, and the caller must know the order secret.
+func (s *Service) SubmitReview(ctx context.Context, req *SubmitReviewRequest) (*Review, error) {
+	tenantID, err := tenant.ID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := s.ordersStorage.OrderByID(ctx, tenantID, req.OrderID)
+	if err != nil {
+		if errors.Is(err, ordersrepo.ErrNotFound) {
+			return nil, ErrInvalidSecret
+		}
+
+		return nil, fmt.Errorf("failed to get order | %w", err)
+	}
+
+	if subtle.ConstantTimeCompare([]byte(order.ReviewSecret), []byte(req.Secret)) != 1 {
+		return nil, ErrInvalidSecret
+	}
+
+	if order.OrderStatus != ordersrepo.OrderStatusDone {
+		return nil, ErrOrderNotDone
+	}
+
+	review, err := s.reviewStorage.SubmitReview(ctx, &repo.SubmitReviewRequest{
+		TenantID: tenantID,
+		OrderID:  req.OrderID,
+		Name:     req.Name,
+		Rate:     req.Rate,
+		Text:     req.Text,
+	})
+	if err != nil {
+		if errors.Is(err, repo.ErrAlreadyExists) {
+			return nil, ErrAlreadyExists
+		}
+
+		return nil, fmt.Errorf("failed to submit review | %w", err)
+	}
+
+	result := toReview(review)
+
+	return &result, nil
+}
+
+func (s *Service) ApproveReview(ctx context.Context, id uint64) error {
+	return s.moderateReview(ctx, id, repo.ReviewStatusApproved)
+}
+
+func (s *Service) RejectReview(ctx context.Context, id uint64) error {
+	return s.moderateReview(ctx, id, repo.ReviewStatusRejected)
+}
+
+func (s *Service) moderateReview(ctx context.Context, id uint64, status repo.ReviewStatus) error {
+	tenantID, err := tenant.ID(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err = s.reviewStorage.ModerateReview(ctx, tenantID, id, status); err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return ErrNotFound
+		}
+
+		return fmt.Errorf("failed to moderate review | %w", err)
+	}
+
+	return nil
+}
+
+func toReviews(repoReviews []*repo.Review) []Review {
 	reviews := make([]Review, 0, len(repoReviews))
 
-	for _, repoOrder := range repoReviews {
-		reviews = append(reviews, Review{
-			Text:     repoOrder.Text,
-			Name:     repoOrder.Name,
-			PhotoURL: repoOrder.PhotoURL,
-			URL:      repoOrder.URL,
-			Rate:     repoOrder.Rate,
-		})
+	for _, repoReview := range repoReviews {
+		reviews = append(reviews, toReview(repoReview))
 	}
 
-	return reviews, nil
+	return reviews
+}
+
+func toReview(repoReview *repo.Review) Review {
+	return Review{
+		ID:       repoReview.ID,
+		Text:     repoReview.Text,
+		Name:     repoReview.Name,
+		PhotoURL: repoReview.PhotoURL,
+		URL:      repoReview.URL,
+		Rate:     repoReview.Rate,
+		Source:   repoReview.Source,
+	}
 }
 
 type Review struct {
+	ID       uint64
 	Text     string
 	Name     string
 	PhotoURL string
 	URL      string
 	Rate     int32
+	Source   string
+}
+
+type SubmitReviewRequest struct {
+	OrderID uint64
+	Secret  string
+	Name    string
+	Rate    int32
+	Text    string
 }
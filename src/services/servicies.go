@@ -2,9 +2,14 @@ package services
 
 import (
 	"context"
+	"time"
 
+	"github.com/ingvarmattis/moving/src/services/apikeys"
 	"github.com/ingvarmattis/moving/src/services/orders"
 	"github.com/ingvarmattis/moving/src/services/reviews"
+	"github.com/ingvarmattis/moving/src/services/telegram"
+	"github.com/ingvarmattis/moving/src/services/users"
+	"github.com/ingvarmattis/moving/src/services/webhooks"
 )
 
 type OrdersHandlers struct {
@@ -15,13 +20,70 @@ type ReviewsHandlers struct {
 	ReviewsService ReviewsService
 }
 
+type WebhooksHandlers struct {
+	WebhooksService WebhooksService
+}
+
+type TelegramHandlers struct {
+	TelegramService TelegramService
+}
+
+type UsersHandlers struct {
+	UsersService UsersService
+}
+
+type APIKeysHandlers struct {
+	APIKeysService APIKeysService
+}
+
 type OrdersService interface {
 	CreateOrder(ctx context.Context, req *orders.CreateOrderRequest) (*orders.Order, error)
 	Orders(ctx context.Context, filter *orders.Filter) ([]*orders.Order, error)
 	OrderByID(ctx context.Context, id uint64) (*orders.Order, error)
 	UpdateOrder(ctx context.Context, req *orders.UpdateOrderRequest) error
+	OrdersStats(ctx context.Context, filter *orders.Filter, granularity orders.StatsGranularity) (*orders.Stats, error)
+	WatchOrders(ctx context.Context, filter *orders.Filter, send func(change *orders.OrderChange) error) error
 }
 
 type ReviewsService interface {
 	Reviews(ctx context.Context) ([]reviews.Review, error)
+	PendingReviews(ctx context.Context) ([]reviews.Review, error)
+	SubmitReview(ctx context.Context, req *reviews.SubmitReviewRequest) (*reviews.Review, error)
+	ApproveReview(ctx context.Context, id uint64) error
+	RejectReview(ctx context.Context, id uint64) error
+}
+
+type WebhooksService interface {
+	CreateWebhook(ctx context.Context, req *webhooks.CreateWebhookRequest) (*webhooks.Subscription, error)
+	Webhooks(ctx context.Context) ([]webhooks.Subscription, error)
+	DeleteWebhook(ctx context.Context, id uint64) error
+	WebhookDeliveries(ctx context.Context, filter *webhooks.DeliveriesFilter) ([]webhooks.Delivery, error)
+}
+
+type TelegramService interface {
+	CreateInvite(ctx context.Context) (*telegram.Invite, error)
+	Subscribe(ctx context.Context, code string, chatID int64, title string) (*telegram.Subscription, error)
+	Bootstrap(ctx context.Context, chatIDs []int64) ([]int64, error)
+	Subscription(ctx context.Context, chatID int64) (*telegram.Subscription, error)
+	Subscriptions(ctx context.Context) ([]telegram.Subscription, error)
+	ChatIDs(ctx context.Context, event string) ([]int64, error)
+	UpdatePreferences(ctx context.Context, chatID int64, preferences *telegram.Preferences) error
+	Revoke(ctx context.Context, chatID int64) error
+	ClaimDigest(ctx context.Context, digest string, scheduledAt time.Time) (bool, error)
+}
+
+type UsersService interface {
+	Login(ctx context.Context, email, password string) (*users.Session, error)
+	Refresh(ctx context.Context, refreshToken string) (*users.Session, error)
+	Logout(ctx context.Context, refreshToken string) error
+	CreateUser(ctx context.Context, req *users.CreateUserRequest) (*users.User, error)
+	Users(ctx context.Context) ([]users.User, error)
+	DisableUser(ctx context.Context, id uint64) error
+}
+
+type APIKeysService interface {
+	CreateKey(ctx context.Context, req *apikeys.CreateKeyRequest) (*apikeys.CreatedKey, error)
+	Keys(ctx context.Context) ([]apikeys.Key, error)
+	RevokeKey(ctx context.Context, id uint64) error
+	RotateKey(ctx context.Context, id uint64, req *apikeys.RotateKeyRequest) (*apikeys.CreatedKey, error)
 }
@@ -0,0 +1,169 @@
+package sms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/ingvarmattis/moving/src/infra/sms"
+	"github.com/ingvarmattis/moving/src/infra/tenant"
+	repo "github.com/ingvarmattis/moving/src/repositories/sms"
+)
+
+var errInvalidPhone = errors.New("invalid phone")
+
+type smsStorage interface {
+	Reserve(ctx context.Context, orderID uint64, kind, phone string, maxAttempts int) (uint64, error)
+	MarkSent(ctx context.Context, id uint64, body, providerID, status string) error
+	MarkFailed(ctx context.Context, id uint64, body, status, sendErr string) error
+	OrdersToRemind(ctx context.Context, moveDay time.Time, maxAttempts int) ([]*repo.Order, error)
+}
+
+type smsTemplates interface {
+	Render(kind string, data any) (string, error)
+}
+
+type Options struct {
+	DefaultCountryCode string
+	// Reminders are sent after ReminderHour in Location on the day before the move.
+	ReminderHour     int
+	Location         *time.Location
+	ReminderInterval time.Duration
+	// MaxAttempts limits sending of a message failed with a retryable error.
+	MaxAttempts int
+}
+
+type Service struct {
+	storage   smsStorage
+	sender    sms.SMSSender
+	templates smsTemplates
+	logger    *zap.Logger
+	opts      Options
+}
+
+func NewService(
+	storage smsStorage, sender sms.SMSSender, templates smsTemplates, logger *zap.Logger, opts Options,
+) *Service {
+	return &Service{storage: storage, sender: sender, templates: templates, logger: logger, opts: opts}
+}
+
+// SendOrderConfirmation returns an error only when sending may succeed on retry.
+func (s *Service) SendOrderConfirmation(ctx context.Context, order *Order) error {
+	return s.send(ctx, repo.KindOrderConfirmation, order)
+}
+
+// RunReminders texts customers moving tomorrow until ctx is done.
+func (s *Service) RunReminders(ctx context.Context) {
+	ticker := time.NewTicker(s.opts.ReminderInterval)
+	defer ticker.Stop()
+
+	for {
+		s.remind(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Service) remind(ctx context.Context) {
+	now := time.Now().In(s.opts.Location)
+	if now.Hour() < s.opts.ReminderHour {
+		return
+	}
+
+	tomorrow := now.AddDate(0, 0, 1)
+
+	orders, err := s.storage.OrdersToRemind(
+		ctx, time.Date(tomorrow.Year(), tomorrow.Month(), tomorrow.Day(), 0, 0, 0, 0, time.UTC), s.opts.MaxAttempts,
+	)
+	if err != nil {
+		if ctx.Err() == nil {
+			s.logger.Error("failed to get orders to remind", zap.Error(err))
+		}
+
+		return
+	}
+
+	for _, order := range orders {
+		if err = s.send(ctx, repo.KindMoveReminder, newOrder(order)); err != nil {
+			s.logger.Error("failed to send move reminder", zap.Error(err), zap.Uint64("order_id", order.ID))
+		}
+	}
+}
+
+func (s *Service) send(ctx context.Context, kind string, order *Order) error {
+	phone, phoneOK := sms.NormalizePhone(order.Phone, s.opts.DefaultCountryCode)
+	if !phoneOK {
+		phone = order.Phone
+	}
+
+	id, err := s.storage.Reserve(ctx, order.ID, kind, phone, s.opts.MaxAttempts)
+	if err != nil {
+		if errors.Is(err, repo.ErrAlreadySent) {
+			return nil
+		}
+
+		return fmt.Errorf("failed to reserve sms | %w", err)
+	}
+
+	if !phoneOK {
+		// retrying will not fix the number, so the failure is only recorded
+		return s.storage.MarkFailed(ctx, id, "", sms.StatusRejected, errInvalidPhone.Error())
+	}
+
+	body, err := s.templates.Render(kind, order)
+	if err != nil {
+		return errors.Join(err, s.storage.MarkFailed(ctx, id, "", sms.StatusFailed, err.Error()))
+	}
+
+	result, err := s.sender.Send(ctx, phone, body)
+	if err != nil {
+		status := sms.StatusFailed
+
+		switch {
+		case errors.Is(err, sms.ErrRejected):
+			status = sms.StatusRejected
+		case errors.Is(err, sms.ErrUnconfirmed):
+			status = sms.StatusUnconfirmed
+		default:
+			return errors.Join(err, s.storage.MarkFailed(ctx, id, body, status, err.Error()))
+		}
+
+		s.logger.Warn("sms is not sent", zap.Error(err), zap.Uint64("order_id", order.ID), zap.String("kind", kind))
+
+		return s.storage.MarkFailed(ctx, id, body, status, err.Error())
+	}
+
+	return s.storage.MarkSent(ctx, id, body, result.ProviderID, result.Status)
+}
+
+type Order struct {
+	ID       uint64
+	Name     string
+	Phone    string
+	MoveDate time.Time
+	MoveFrom string
+	MoveTo   string
+	// Brand is the tenant the customer ordered from, texts are signed with it.
+	Brand tenant.Branding
+	// ReviewURL carries the review secret of the order, it is sent only with the confirmation.
+	ReviewURL string
+}
+
+func newOrder(order *repo.Order) *Order {
+	return &Order{
+		ID:       order.ID,
+		Name:     order.Name,
+		Phone:    order.Phone,
+		MoveDate: order.MoveDate,
+		MoveFrom: order.MoveFrom,
+		MoveTo:   order.MoveTo,
+		Brand:    tenant.Branding{Name: order.BrandName, Phone: order.BrandPhone},
+	}
+}
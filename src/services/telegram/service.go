@@ -0,0 +1,237 @@
+package telegram
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ingvarmattis/moving/src/infra/tenant"
+	repo "github.com/ingvarmattis/moving/src/repositories/telegram"
+)
+
+const (
+	EventNewOrders     = repo.EventNewOrders
+	EventStatusChanges = repo.EventStatusChanges
+	EventDigests       = repo.EventDigests
+)
+
+// inviteCodeSize random bytes give a code telegram accepts as a /start deep link payload.
+const inviteCodeSize = 18
+
+var (
+	ErrNotFound      = errors.New("not found")
+	ErrInvalidInvite = errors.New("invite code is invalid, used or expired")
+)
+
+type subscriptionsStorage interface {
+	CreateInvite(ctx context.Context, tenantID uint64, code string, expiresAt time.Time) (*repo.Invite, error)
+	Redeem(ctx context.Context, code string, chatID int64, title string) (*repo.Subscription, error)
+	Bootstrap(ctx context.Context, tenantID uint64, chatIDs []int64) ([]int64, error)
+	Subscription(ctx context.Context, chatID int64) (*repo.Subscription, error)
+	Subscriptions(ctx context.Context, tenantID uint64) ([]*repo.Subscription, error)
+	ChatIDs(ctx context.Context, tenantID uint64, event string) ([]int64, error)
+	UpdatePreferences(ctx context.Context, tenantID uint64, chatID int64, preferences *repo.Preferences) error
+	Revoke(ctx context.Context, tenantID uint64, chatID int64) error
+	ClaimDigest(ctx context.Context, tenantID uint64, digest string, scheduledAt time.Time) (bool, error)
+}
+
+type Service struct {
+	storage   subscriptionsStorage
+	inviteTTL time.Duration
+}
+
+func NewService(storage subscriptionsStorage, inviteTTL time.Duration) *Service {
+	return &Service{storage: storage, inviteTTL: inviteTTL}
+}
+
+// CreateInvite returns a single use code which subscribes a chat sending /start with it to the tenant of the call.
+func (s *Service) CreateInvite(ctx context.Context) (*Invite, error) {
+	tenantID, err := tenant.ID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	random := make([]byte, inviteCodeSize)
+	if _, err = rand.Read(random); err != nil {
+		return nil, fmt.Errorf("failed to generate invite code | %w", err)
+	}
+
+	invite, err := s.storage.CreateInvite(
+		ctx, tenantID, base64.RawURLEncoding.EncodeToString(random), time.Now().Add(s.inviteTTL),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create invite | %w", err)
+	}
+
+	return &Invite{Code: invite.Code, ExpiresAt: invite.ExpiresAt}, nil
+}
+
+// Subscribe redeems the invite, the chat joins the tenant the invite was created for.
+func (s *Service) Subscribe(ctx context.Context, code string, chatID int64, title string) (*Subscription, error) {
+	subscription, err := s.storage.Redeem(ctx, code, chatID, title)
+	if err != nil {
+		if errors.Is(err, repo.ErrInvalidInvite) {
+			return nil, ErrInvalidInvite
+		}
+
+		return nil, fmt.Errorf("failed to subscribe chat | %w", err)
+	}
+
+	result := toSubscription(subscription)
+
+	return &result, nil
+}
+
+// Bootstrap keeps the statically configured chats of the tenant of the call in line with the config,
+// chats removed from it are revoked unless they joined with an invite since. It returns the revoked chats,
+// an empty list revokes nothing.
+func (s *Service) Bootstrap(ctx context.Context, chatIDs []int64) ([]int64, error) {
+	tenantID, err := tenant.ID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.storage.Bootstrap(ctx, tenantID, chatIDs)
+}
+
+// Subscription looks the chat up in every tenant, TenantID of the result tells which one the chat belongs to.
+func (s *Service) Subscription(ctx context.Context, chatID int64) (*Subscription, error) {
+	subscription, err := s.storage.Subscription(ctx, chatID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get subscription | %w", err)
+	}
+
+	result := toSubscription(subscription)
+
+	return &result, nil
+}
+
+func (s *Service) Subscriptions(ctx context.Context) ([]Subscription, error) {
+	tenantID, err := tenant.ID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptions, err := s.storage.Subscriptions(ctx, tenantID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get subscriptions | %w", err)
+	}
+
+	result := make([]Subscription, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		result = append(result, toSubscription(subscription))
+	}
+
+	return result, nil
+}
+
+func (s *Service) ChatIDs(ctx context.Context, event string) ([]int64, error) {
+	tenantID, err := tenant.ID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	chatIDs, err := s.storage.ChatIDs(ctx, tenantID, event)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chats | %w", err)
+	}
+
+	return chatIDs, nil
+}
+
+func (s *Service) UpdatePreferences(ctx context.Context, chatID int64, preferences *Preferences) error {
+	tenantID, err := tenant.ID(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err = s.storage.UpdatePreferences(ctx, tenantID, chatID, &repo.Preferences{
+		NewOrders:     preferences.NewOrders,
+		StatusChanges: preferences.StatusChanges,
+		Digests:       preferences.Digests,
+	}); err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return ErrNotFound
+		}
+
+		return fmt.Errorf("failed to update preferences | %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) Revoke(ctx context.Context, chatID int64) error {
+	tenantID, err := tenant.ID(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err = s.storage.Revoke(ctx, tenantID, chatID); err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return ErrNotFound
+		}
+
+		return fmt.Errorf("failed to revoke chat | %w", err)
+	}
+
+	return nil
+}
+
+// ClaimDigest tells if this replica should post the digest of the tenant scheduled at the time.
+func (s *Service) ClaimDigest(ctx context.Context, digest string, scheduledAt time.Time) (bool, error) {
+	tenantID, err := tenant.ID(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	claimed, err := s.storage.ClaimDigest(ctx, tenantID, digest, scheduledAt)
+	if err != nil {
+		return false, fmt.Errorf("failed to claim digest | %w", err)
+	}
+
+	return claimed, nil
+}
+
+func toSubscription(subscription *repo.Subscription) Subscription {
+	return Subscription{
+		ChatID:   subscription.ChatID,
+		TenantID: subscription.TenantID,
+		Title:    subscription.Title,
+		Preferences: Preferences{
+			NewOrders:     subscription.Preferences.NewOrders,
+			StatusChanges: subscription.Preferences.StatusChanges,
+			Digests:       subscription.Preferences.Digests,
+		},
+		CreatedAt: subscription.CreatedAt,
+	}
+}
+
+type Invite struct {
+	Code      string
+	ExpiresAt time.Time
+}
+
+type Preferences struct {
+	NewOrders     bool
+	StatusChanges bool
+	Digests       bool
+}
+
+type Subscription struct {
+	ChatID      int64
+	TenantID    uint64
+	Title       string
+	Preferences Preferences
+	CreatedAt   time.Time
+}
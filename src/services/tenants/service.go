@@ -0,0 +1,151 @@
+package tenants
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ingvarmattis/moving/src/infra/tenant"
+	repo "github.com/ingvarmattis/moving/src/repositories/tenants"
+)
+
+var ErrNotFound = errors.New("tenant not found")
+
+type tenantsStorage interface {
+	Tenants(ctx context.Context) ([]*repo.Tenant, error)
+}
+
+// Service tenants are cached for cacheTTL, it is how long a changed tenant may be served as before.
+type Service struct {
+	storage     tenantsStorage
+	defaultSlug string
+	cacheTTL    time.Duration
+
+	mu       sync.Mutex
+	tenants  []*tenant.Tenant
+	loadedAt time.Time
+}
+
+func NewService(storage tenantsStorage, defaultSlug string, cacheTTL time.Duration) *Service {
+	return &Service{storage: storage, defaultSlug: defaultSlug, cacheTTL: cacheTTL}
+}
+
+// Tenants returns the active tenants.
+func (s *Service) Tenants(ctx context.Context) ([]*tenant.Tenant, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.tenants != nil && time.Since(s.loadedAt) < s.cacheTTL {
+		return s.tenants, nil
+	}
+
+	repoTenants, err := s.storage.Tenants(ctx)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get tenants | %w", err)
+	}
+
+	tenants := make([]*tenant.Tenant, 0, len(repoTenants))
+	for _, repoTenant := range repoTenants {
+		t, toErr := toTenant(repoTenant)
+		if toErr != nil {
+			return nil, toErr
+		}
+
+		tenants = append(tenants, t)
+	}
+
+	s.tenants = tenants
+	s.loadedAt = time.Now()
+
+	return tenants, nil
+}
+
+func (s *Service) TenantByID(ctx context.Context, id uint64) (*tenant.Tenant, error) {
+	tenants, err := s.Tenants(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range tenants {
+		if t.ID == id {
+			return t, nil
+		}
+	}
+
+	return nil, ErrNotFound
+}
+
+// TenantByHost returns the tenant claiming the host, the default tenant serves hosts no tenant claims.
+// The port of the host is ignored.
+func (s *Service) TenantByHost(ctx context.Context, host string) (*tenant.Tenant, error) {
+	tenants, err := s.Tenants(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if hostname, _, splitErr := net.SplitHostPort(host); splitErr == nil {
+		host = hostname
+	}
+
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+
+	for _, t := range tenants {
+		for _, tenantHost := range t.Hosts {
+			if strings.EqualFold(tenantHost, host) {
+				return t, nil
+			}
+		}
+	}
+
+	return s.defaultTenant(tenants)
+}
+
+// Default returns the tenant serving hosts no tenant claims and chats allowed by the config.
+func (s *Service) Default(ctx context.Context) (*tenant.Tenant, error) {
+	tenants, err := s.Tenants(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.defaultTenant(tenants)
+}
+
+func (s *Service) defaultTenant(tenants []*tenant.Tenant) (*tenant.Tenant, error) {
+	for _, t := range tenants {
+		if t.Slug == s.defaultSlug {
+			return t, nil
+		}
+	}
+
+	return nil, ErrNotFound
+}
+
+func toTenant(repoTenant *repo.Tenant) (*tenant.Tenant, error) {
+	var pricing tenant.Pricing
+	if err := json.Unmarshal(repoTenant.Pricing, &pricing); err != nil {
+		return nil, fmt.Errorf("failed to decode pricing of tenant %s | %w", repoTenant.Slug, err)
+	}
+
+	return &tenant.Tenant{
+		ID:    repoTenant.ID,
+		Slug:  repoTenant.Slug,
+		Name:  repoTenant.Name,
+		Hosts: repoTenant.Hosts,
+		Branding: tenant.Branding{
+			Name:    repoTenant.Name,
+			Phone:   repoTenant.BrandPhone,
+			Email:   repoTenant.BrandEmail,
+			SiteURL: repoTenant.BrandSiteURL,
+		},
+		Pricing: pricing,
+	}, nil
+}
@@ -0,0 +1,282 @@
+package users
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/ingvarmattis/moving/src/infra/identity"
+	"github.com/ingvarmattis/moving/src/infra/tenant"
+	repo "github.com/ingvarmattis/moving/src/repositories/users"
+)
+
+const refreshTokenSize = 32
+
+var (
+	ErrNotFound            = errors.New("not found")
+	ErrAlreadyExists       = errors.New("user with this email already exists")
+	ErrInvalidCredentials  = errors.New("invalid email or password")
+	ErrInvalidRefreshToken = errors.New("refresh token is invalid, revoked or expired")
+	ErrLoginDisabled       = errors.New("login is disabled, jwt secret is not set")
+)
+
+// dummyPasswordHash is compared when the user is not found, so a login takes as long for any email.
+const dummyPasswordHash = "$2a$10$rq.iXegPSlxFfsPuZ0ZzZOsmC593mxSp6U9W5TsleckwqjOI9wU8y"
+
+type usersStorage interface {
+	CreateUser(ctx context.Context, req *repo.CreateUserRequest) (*repo.User, error)
+	UserByEmail(ctx context.Context, email string) (*repo.User, error)
+	Users(ctx context.Context, tenantID *uint64) ([]*repo.User, error)
+	DisableUser(ctx context.Context, tenantID *uint64, id uint64) error
+	CreateRefreshToken(ctx context.Context, userID uint64, tokenHash string, expiresAt time.Time) error
+	RotateRefreshToken(ctx context.Context, tokenHash, newTokenHash string, expiresAt time.Time) (*repo.User, error)
+	RevokeRefreshToken(ctx context.Context, tokenHash string) error
+}
+
+type accessTokens interface {
+	Enabled() bool
+	Issue(identity *identity.Identity) (string, time.Time, error)
+}
+
+type Service struct {
+	storage    usersStorage
+	tokens     accessTokens
+	refreshTTL time.Duration
+}
+
+func NewService(storage usersStorage, tokens accessTokens, refreshTTL time.Duration) *Service {
+	return &Service{storage: storage, tokens: tokens, refreshTTL: refreshTTL}
+}
+
+// Login checks the password and starts a session of the user.
+func (s *Service) Login(ctx context.Context, email, password string) (*Session, error) {
+	if !s.tokens.Enabled() {
+		return nil, ErrLoginDisabled
+	}
+
+	user, err := s.storage.UserByEmail(ctx, strings.TrimSpace(email))
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			_ = bcrypt.CompareHashAndPassword([]byte(dummyPasswordHash), []byte(password))
+
+			return nil, ErrInvalidCredentials
+		}
+
+		return nil, fmt.Errorf("failed to get user | %w", err)
+	}
+
+	if err = bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)); err != nil {
+		return nil, ErrInvalidCredentials
+	}
+
+	refreshToken, refreshTokenHash, err := newRefreshToken()
+	if err != nil {
+		return nil, err
+	}
+
+	refreshExpiresAt := time.Now().Add(s.refreshTTL)
+
+	if err = s.storage.CreateRefreshToken(ctx, user.ID, refreshTokenHash, refreshExpiresAt); err != nil {
+		return nil, fmt.Errorf("failed to create refresh token | %w", err)
+	}
+
+	return s.session(user, refreshToken, refreshExpiresAt)
+}
+
+// Refresh exchanges the refresh token for a new session, the token can be used once.
+// Roles changed since the login are applied to the new access token.
+func (s *Service) Refresh(ctx context.Context, refreshToken string) (*Session, error) {
+	// checked before the rotation, which would use the refresh token up
+	if !s.tokens.Enabled() {
+		return nil, ErrLoginDisabled
+	}
+
+	newToken, newTokenHash, err := newRefreshToken()
+	if err != nil {
+		return nil, err
+	}
+
+	refreshExpiresAt := time.Now().Add(s.refreshTTL)
+
+	user, err := s.storage.RotateRefreshToken(ctx, hashRefreshToken(refreshToken), newTokenHash, refreshExpiresAt)
+	if err != nil {
+		if errors.Is(err, repo.ErrInvalidRefreshToken) {
+			return nil, ErrInvalidRefreshToken
+		}
+
+		return nil, fmt.Errorf("failed to rotate refresh token | %w", err)
+	}
+
+	return s.session(user, newToken, refreshExpiresAt)
+}
+
+// Logout revokes the refresh token, the access token stays valid until it expires.
+func (s *Service) Logout(ctx context.Context, refreshToken string) error {
+	if err := s.storage.RevokeRefreshToken(ctx, hashRefreshToken(refreshToken)); err != nil {
+		return fmt.Errorf("failed to revoke refresh token | %w", err)
+	}
+
+	return nil
+}
+
+// CreateUser adds a user of the tenant of the call.
+func (s *Service) CreateUser(ctx context.Context, req *CreateUserRequest) (*User, error) {
+	tenantID, err := tenant.ID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash password | %w", err)
+	}
+
+	roles := make([]string, 0, len(req.Roles))
+	for _, role := range req.Roles {
+		roles = append(roles, string(role))
+	}
+
+	user, err := s.storage.CreateUser(ctx, &repo.CreateUserRequest{
+		TenantID:     &tenantID,
+		Email:        strings.TrimSpace(req.Email),
+		Name:         req.Name,
+		PasswordHash: string(passwordHash),
+		Roles:        roles,
+	})
+	if err != nil {
+		if errors.Is(err, repo.ErrAlreadyExists) {
+			return nil, ErrAlreadyExists
+		}
+
+		return nil, fmt.Errorf("failed to create user | %w", err)
+	}
+
+	result := toUser(user)
+
+	return &result, nil
+}
+
+// Users returns the users of the tenant the caller is bound to, every user for an unbound caller.
+func (s *Service) Users(ctx context.Context) ([]User, error) {
+	users, err := s.storage.Users(ctx, identity.TenantID(ctx))
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get users | %w", err)
+	}
+
+	result := make([]User, 0, len(users))
+	for _, user := range users {
+		result = append(result, toUser(user))
+	}
+
+	return result, nil
+}
+
+// DisableUser ends the sessions of the user, issued access tokens are valid until they expire.
+func (s *Service) DisableUser(ctx context.Context, id uint64) error {
+	if err := s.storage.DisableUser(ctx, identity.TenantID(ctx), id); err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return ErrNotFound
+		}
+
+		return fmt.Errorf("failed to disable user | %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) session(user *repo.User, refreshToken string, refreshExpiresAt time.Time) (*Session, error) {
+	result := toUser(user)
+
+	accessToken, accessExpiresAt, err := s.tokens.Issue(&identity.Identity{
+		UserID: result.ID, TenantID: result.TenantID, Roles: result.Roles,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to issue access token | %w", err)
+	}
+
+	return &Session{
+		AccessToken:      accessToken,
+		AccessExpiresAt:  accessExpiresAt,
+		RefreshToken:     refreshToken,
+		RefreshExpiresAt: refreshExpiresAt,
+		User:             result,
+	}, nil
+}
+
+// newRefreshToken returns the opaque token given to the user and its hash kept in the storage.
+func newRefreshToken() (string, string, error) {
+	random := make([]byte, refreshTokenSize)
+	if _, err := rand.Read(random); err != nil {
+		return "", "", fmt.Errorf("failed to generate refresh token | %w", err)
+	}
+
+	token := base64.RawURLEncoding.EncodeToString(random)
+
+	return token, hashRefreshToken(token), nil
+}
+
+func hashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:])
+}
+
+func toUser(user *repo.User) User {
+	roles := make([]identity.Role, 0, len(user.Roles))
+	for _, role := range user.Roles {
+		roles = append(roles, identity.Role(role))
+	}
+
+	var tenantID uint64
+	if user.TenantID != nil {
+		tenantID = *user.TenantID
+	}
+
+	return User{
+		ID:        user.ID,
+		TenantID:  tenantID,
+		Email:     user.Email,
+		Name:      user.Name,
+		Roles:     roles,
+		CreatedAt: user.CreatedAt,
+		Disabled:  user.DisabledAt != nil,
+	}
+}
+
+type CreateUserRequest struct {
+	Email    string
+	Name     string
+	Password string
+	Roles    []identity.Role
+}
+
+// User with zero TenantID works with every tenant.
+type User struct {
+	ID        uint64
+	TenantID  uint64
+	Email     string
+	Name      string
+	Roles     []identity.Role
+	CreatedAt time.Time
+	Disabled  bool
+}
+
+type Session struct {
+	AccessToken      string
+	AccessExpiresAt  time.Time
+	RefreshToken     string
+	RefreshExpiresAt time.Time
+	User             User
+}
@@ -0,0 +1,401 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/ingvarmattis/moving/src/infra/tenant"
+	"github.com/ingvarmattis/moving/src/infra/utils"
+	repo "github.com/ingvarmattis/moving/src/repositories/webhooks"
+)
+
+const (
+	DeliveryStatusPending   = repo.DeliveryStatusPending
+	DeliveryStatusDelivered = repo.DeliveryStatusDelivered
+	DeliveryStatusFailed    = repo.DeliveryStatusFailed
+)
+
+const (
+	SignatureHeader = "X-Webhook-Signature"
+	EventHeader     = "X-Webhook-Event"
+	DeliveryHeader  = "X-Webhook-Delivery"
+
+	secretPrefix = "whsec_"
+
+	resultRetry = "retry"
+
+	defaultDeliveriesLimit = 50
+	maxDeliveriesLimit     = 500
+
+	// maxErrorBodySize is how much of a failed response is kept in the delivery log.
+	maxErrorBodySize = 512
+)
+
+var ErrNotFound = errors.New("not found")
+
+type webhooksStorage interface {
+	CreateSubscription(
+		ctx context.Context, tenantID uint64, url string, eventTypes []string, secret string,
+	) (*repo.Subscription, error)
+	Subscriptions(ctx context.Context, tenantID uint64) ([]*repo.Subscription, error)
+	DeleteSubscription(ctx context.Context, tenantID, id uint64) error
+	Fanout(
+		ctx context.Context, tenantID, outboxEventID uint64, eventType string, orderID uint64, payload []byte,
+	) error
+	ClaimDeliveries(ctx context.Context, limit int, lease time.Duration) ([]*repo.Delivery, error)
+	MarkDelivered(ctx context.Context, id uint64, responseStatus int) error
+	Retry(ctx context.Context, id uint64, nextAttemptAt time.Time, responseStatus *int, lastError string) error
+	MarkFailed(ctx context.Context, id uint64, responseStatus *int, lastError string) error
+	Deliveries(ctx context.Context, filter *repo.DeliveriesFilter) ([]*repo.Delivery, error)
+}
+
+type webhooksMetrics interface {
+	WebhookDelivery(result string)
+}
+
+type Options struct {
+	PollInterval time.Duration
+	BatchSize    int
+	Lease        time.Duration
+	Timeout      time.Duration
+	MaxAttempts  int
+	BaseBackoff  time.Duration
+	MaxBackoff   time.Duration
+}
+
+type Service struct {
+	storage webhooksStorage
+	metrics webhooksMetrics
+	logger  *zap.Logger
+	client  *http.Client
+	opts    Options
+}
+
+func NewService(storage webhooksStorage, metrics webhooksMetrics, logger *zap.Logger, opts Options) *Service {
+	return &Service{
+		storage: storage,
+		metrics: metrics,
+		logger:  logger,
+		client:  &http.Client{Timeout: opts.Timeout},
+		opts:    opts,
+	}
+}
+
+// CreateWebhook returns the subscription of the tenant of the call with its secret,
+// it is not shown anymore afterwards.
+func (s *Service) CreateWebhook(ctx context.Context, req *CreateWebhookRequest) (*Subscription, error) {
+	tenantID, err := tenant.ID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	secret := ""
+	if req.Secret != nil {
+		secret = *req.Secret
+	}
+
+	if secret == "" {
+		random := make([]byte, 32)
+		if _, err = rand.Read(random); err != nil {
+			return nil, fmt.Errorf("failed to generate secret | %w", err)
+		}
+
+		secret = secretPrefix + hex.EncodeToString(random)
+	}
+
+	subscription, err := s.storage.CreateSubscription(ctx, tenantID, req.URL, req.EventTypes, secret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook | %w", err)
+	}
+
+	result := toSubscription(subscription)
+	result.Secret = &subscription.Secret
+
+	return &result, nil
+}
+
+func (s *Service) Webhooks(ctx context.Context) ([]Subscription, error) {
+	tenantID, err := tenant.ID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptions, err := s.storage.Subscriptions(ctx, tenantID)
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get webhooks | %w", err)
+	}
+
+	result := make([]Subscription, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		result = append(result, toSubscription(subscription))
+	}
+
+	return result, nil
+}
+
+func (s *Service) DeleteWebhook(ctx context.Context, id uint64) error {
+	tenantID, err := tenant.ID(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err = s.storage.DeleteSubscription(ctx, tenantID, id); err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return ErrNotFound
+		}
+
+		return fmt.Errorf("failed to delete webhook | %w", err)
+	}
+
+	return nil
+}
+
+func (s *Service) WebhookDeliveries(ctx context.Context, filter *DeliveriesFilter) ([]Delivery, error) {
+	tenantID, err := tenant.ID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := uint64(filter.Limit)
+	if limit == 0 {
+		limit = defaultDeliveriesLimit
+	}
+
+	deliveries, err := s.storage.Deliveries(ctx, &repo.DeliveriesFilter{
+		TenantID:       tenantID,
+		SubscriptionID: filter.SubscriptionID,
+		Status:         filter.Status,
+		Limit:          min(limit, maxDeliveriesLimit),
+	})
+	if err != nil {
+		if errors.Is(err, repo.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get webhook deliveries | %w", err)
+	}
+
+	result := make([]Delivery, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		result = append(result, toDelivery(delivery))
+	}
+
+	return result, nil
+}
+
+// Fanout schedules deliveries of an order event to every subscription of its type in the tenant of the order.
+func (s *Service) Fanout(
+	ctx context.Context, tenantID, outboxEventID uint64, eventType string, orderID uint64, payload []byte,
+) error {
+	return s.storage.Fanout(ctx, tenantID, outboxEventID, eventType, orderID, payload)
+}
+
+// Run delivers pending webhooks until ctx is done.
+func (s *Service) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.opts.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		s.dispatch(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Service) dispatch(ctx context.Context) {
+	for ctx.Err() == nil {
+		deliveries, err := s.storage.ClaimDeliveries(ctx, s.opts.BatchSize, s.opts.Lease)
+		if err != nil {
+			if ctx.Err() == nil {
+				s.logger.Error("failed to claim webhook deliveries", zap.Error(err))
+			}
+
+			return
+		}
+
+		for _, delivery := range deliveries {
+			s.deliver(ctx, delivery)
+		}
+
+		if len(deliveries) < s.opts.BatchSize {
+			return
+		}
+	}
+}
+
+func (s *Service) deliver(ctx context.Context, delivery *repo.Delivery) {
+	logger := s.logger.With(
+		zap.Uint64("delivery_id", delivery.ID),
+		zap.Uint64("subscription_id", delivery.SubscriptionID),
+		zap.Int("attempt", delivery.Attempts),
+	)
+
+	// the delivery state is saved even if the service is stopping
+	storageCTX := context.WithoutCancel(ctx)
+
+	responseStatus, err := s.send(ctx, delivery)
+	if err == nil {
+		if err = s.storage.MarkDelivered(storageCTX, delivery.ID, *responseStatus); err != nil {
+			logger.Error("failed to mark webhook delivered", zap.Error(err))
+		}
+
+		s.metrics.WebhookDelivery(DeliveryStatusDelivered)
+
+		return
+	}
+
+	if delivery.Attempts >= s.opts.MaxAttempts {
+		logger.Warn("webhook delivery failed, giving up", zap.Error(err))
+
+		if err = s.storage.MarkFailed(storageCTX, delivery.ID, responseStatus, err.Error()); err != nil {
+			logger.Error("failed to mark webhook failed", zap.Error(err))
+		}
+
+		s.metrics.WebhookDelivery(DeliveryStatusFailed)
+
+		return
+	}
+
+	nextAttemptAt := time.Now().Add(utils.Backoff(s.opts.BaseBackoff, s.opts.MaxBackoff, delivery.Attempts))
+
+	if err = s.storage.Retry(storageCTX, delivery.ID, nextAttemptAt, responseStatus, err.Error()); err != nil {
+		logger.Error("failed to schedule webhook retry", zap.Error(err))
+	}
+
+	s.metrics.WebhookDelivery(resultRetry)
+}
+
+// send posts the event, responseStatus is nil when no response was received.
+func (s *Service) send(ctx context.Context, delivery *repo.Delivery) (*int, error) {
+	body, err := json.Marshal(struct {
+		ID        uint64          `json:"id"`
+		Type      string          `json:"type"`
+		CreatedAt time.Time       `json:"created_at"`
+		Data      json.RawMessage `json:"data"`
+	}{
+		ID:        delivery.ID,
+		Type:      delivery.EventType,
+		CreatedAt: delivery.CreatedAt,
+		Data:      delivery.Payload,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal webhook body | %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build webhook request | %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, delivery.EventType)
+	req.Header.Set(DeliveryHeader, strconv.FormatUint(delivery.ID, 10))
+	req.Header.Set(SignatureHeader, Sign(delivery.Secret, time.Now(), body))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send webhook | %w", err)
+	}
+	defer resp.Body.Close()
+
+	responseStatus := resp.StatusCode
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		snippet, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		return &responseStatus, fmt.Errorf("unexpected status %d: %s", resp.StatusCode, snippet)
+	}
+
+	return &responseStatus, nil
+}
+
+// Sign returns "t=<unix time>,v1=<hex HMAC-SHA256 of "<unix time>.<body>">".
+// Receivers should recompute the signature and reject old timestamps to prevent replays.
+func Sign(secret string, at time.Time, body []byte) string {
+	timestamp := strconv.FormatInt(at.Unix(), 10)
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(body)
+
+	return "t=" + timestamp + ",v1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func toSubscription(subscription *repo.Subscription) Subscription {
+	return Subscription{
+		ID:         subscription.ID,
+		URL:        subscription.URL,
+		EventTypes: subscription.EventTypes,
+		CreatedAt:  subscription.CreatedAt,
+	}
+}
+
+func toDelivery(delivery *repo.Delivery) Delivery {
+	return Delivery{
+		ID:             delivery.ID,
+		SubscriptionID: delivery.SubscriptionID,
+		EventType:      delivery.EventType,
+		OrderID:        delivery.OrderID,
+		Status:         delivery.Status,
+		Attempts:       delivery.Attempts,
+		ResponseStatus: delivery.ResponseStatus,
+		LastError:      delivery.LastError,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		CreatedAt:      delivery.CreatedAt,
+		DeliveredAt:    delivery.DeliveredAt,
+	}
+}
+
+type Subscription struct {
+	ID         uint64
+	URL        string
+	EventTypes []string
+	Secret     *string
+	CreatedAt  time.Time
+}
+
+type CreateWebhookRequest struct {
+	URL        string
+	EventTypes []string
+	Secret     *string
+}
+
+type Delivery struct {
+	ID             uint64
+	SubscriptionID uint64
+	EventType      string
+	OrderID        uint64
+	Status         string
+	Attempts       int
+	ResponseStatus *int
+	LastError      *string
+	NextAttemptAt  time.Time
+	CreatedAt      time.Time
+	DeliveredAt    *time.Time
+}
+
+type DeliveriesFilter struct {
+	SubscriptionID *uint64
+	Status         *string
+	Limit          uint32
+}
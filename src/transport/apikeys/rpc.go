@@ -0,0 +1,143 @@
+package apikeys
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ingvarmattis/moving/src/infra/identity"
+	"github.com/ingvarmattis/moving/src/services"
+	apikeyssvc "github.com/ingvarmattis/moving/src/services/apikeys"
+)
+
+var (
+	ErrNotFound     = errors.New("not found")
+	ErrScopeNotHeld = errors.New("the caller does not hold the scope")
+)
+
+type Handlers struct {
+	APIKeysService services.APIKeysService
+}
+
+func (s *Handlers) CreateKey(ctx context.Context, req *CreateKeyRequest) (*CreatedKey, error) {
+	scopes := make([]identity.Scope, 0, len(req.Scopes))
+	for _, scope := range req.Scopes {
+		scopes = append(scopes, identity.Scope(scope))
+	}
+
+	key, err := s.APIKeysService.CreateKey(ctx, &apikeyssvc.CreateKeyRequest{
+		Name:      req.Name,
+		Scopes:    scopes,
+		ExpiresAt: req.ExpiresAt,
+	})
+	if err != nil {
+		if errors.Is(err, apikeyssvc.ErrScopeNotHeld) {
+			return nil, fmt.Errorf("%w | %w", ErrScopeNotHeld, err)
+		}
+
+		return nil, fmt.Errorf("failed create api key | %w", err)
+	}
+
+	return toCreatedKey(key), nil
+}
+
+func (s *Handlers) Keys(ctx context.Context) ([]Key, error) {
+	keys, err := s.APIKeysService.Keys(ctx)
+	if err != nil {
+		if errors.Is(err, apikeyssvc.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+
+		return nil, fmt.Errorf("failed get api keys | %w", err)
+	}
+
+	result := make([]Key, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, toKey(key))
+	}
+
+	return result, nil
+}
+
+func (s *Handlers) RevokeKey(ctx context.Context, id uint64) error {
+	if err := s.APIKeysService.RevokeKey(ctx, id); err != nil {
+		if errors.Is(err, apikeyssvc.ErrNotFound) {
+			return ErrNotFound
+		}
+
+		return fmt.Errorf("failed revoke api key | %w", err)
+	}
+
+	return nil
+}
+
+func (s *Handlers) RotateKey(ctx context.Context, id uint64, req *RotateKeyRequest) (*CreatedKey, error) {
+	key, err := s.APIKeysService.RotateKey(ctx, id, &apikeyssvc.RotateKeyRequest{
+		ExpiresAt:   req.ExpiresAt,
+		GracePeriod: req.GracePeriod,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, apikeyssvc.ErrNotFound):
+			return nil, ErrNotFound
+		case errors.Is(err, apikeyssvc.ErrScopeNotHeld):
+			return nil, fmt.Errorf("%w | %w", ErrScopeNotHeld, err)
+		}
+
+		return nil, fmt.Errorf("failed rotate api key | %w", err)
+	}
+
+	return toCreatedKey(key), nil
+}
+
+func toCreatedKey(key *apikeyssvc.CreatedKey) *CreatedKey {
+	return &CreatedKey{Key: toKey(key.Key), Secret: key.Secret}
+}
+
+func toKey(key apikeyssvc.Key) Key {
+	scopes := make([]string, 0, len(key.Scopes))
+	for _, scope := range key.Scopes {
+		scopes = append(scopes, string(scope))
+	}
+
+	return Key{
+		ID:         key.ID,
+		Name:       key.Name,
+		Prefix:     key.Prefix,
+		Scopes:     scopes,
+		ExpiresAt:  key.ExpiresAt,
+		LastUsedAt: key.LastUsedAt,
+		CreatedBy:  key.CreatedBy,
+		CreatedAt:  key.CreatedAt,
+		RevokedAt:  key.RevokedAt,
+	}
+}
+
+type CreateKeyRequest struct {
+	Name      string   `validate:"required,max=255"`
+	Scopes    []string `validate:"required,min=1,unique,dive,scope"`
+	ExpiresAt *time.Time
+}
+
+type RotateKeyRequest struct {
+	ExpiresAt   *time.Time
+	GracePeriod *time.Duration `validate:"omitempty,min=0"`
+}
+
+type Key struct {
+	ID         uint64
+	Name       string
+	Prefix     string
+	Scopes     []string
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	CreatedBy  *uint64
+	CreatedAt  time.Time
+	RevokedAt  *time.Time
+}
+
+type CreatedKey struct {
+	Key    Key
+	Secret string
+}
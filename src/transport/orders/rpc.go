@@ -27,6 +27,13 @@ func (s *Handlers) CreateOrder(ctx context.Context, req *CreateOrderRequest) (*O
 		MoveFrom:       req.MoveFrom,
 		MoveTo:         req.MoveTo,
 		AdditionalInfo: req.AdditionalInfo,
+		Source:         orderssvc.LeadSource(req.Source),
+		UTMSource:      req.UTMSource,
+		UTMMedium:      req.UTMMedium,
+		UTMCampaign:    req.UTMCampaign,
+		Referrer:       req.Referrer,
+		LandingPage:    req.LandingPage,
+		SpamReason:     req.SpamReason,
 	}
 
 	order, err := s.OrdersService.CreateOrder(ctx, svcReq)
@@ -45,6 +52,16 @@ func (s *Handlers) CreateOrder(ctx context.Context, req *CreateOrderRequest) (*O
 		MoveFrom:       order.MoveFrom,
 		MoveTo:         order.MoveTo,
 		AdditionalInfo: order.AdditionalInfo,
+		ReviewSecret:   order.ReviewSecret,
+		Source:         LeadSource(order.Source),
+		UTMSource:      order.UTMSource,
+		UTMMedium:      order.UTMMedium,
+		UTMCampaign:    order.UTMCampaign,
+		Referrer:       order.Referrer,
+		LandingPage:    order.LandingPage,
+		SpamReason:     order.SpamReason,
+		ArrivalWindow:  ArrivalWindow(order.ArrivalWindow),
+		UpdatedBy:      order.UpdatedBy,
 		CreatedAt:      order.CreatedAt,
 		UpdatedAt:      order.UpdatedAt,
 	}, nil
@@ -81,19 +98,28 @@ func normalizeFilter(filter *Filter) *orderssvc.Filter {
 		propertySize = &ps
 	}
 
+	var source *orderssvc.LeadSource
+	if filter.Source != nil {
+		ls := orderssvc.LeadSource(*filter.Source)
+		source = &ls
+	}
+
 	// Check if all fields are empty
-	if orderStatus == nil && propertySize == nil && createdFrom == nil &&
-		createdTo == nil && moveDateFrom == nil && moveDateTo == nil {
+	if orderStatus == nil && propertySize == nil && source == nil && !filter.Spam && filter.Search == nil &&
+		createdFrom == nil && createdTo == nil && moveDateFrom == nil && moveDateTo == nil {
 		return nil
 	}
 
 	return &orderssvc.Filter{
 		OrderStatus:  orderStatus,
 		PropertySize: propertySize,
+		Source:       source,
+		Spam:         filter.Spam,
 		CreatedFrom:  createdFrom,
 		CreatedTo:    createdTo,
 		MoveDateFrom: moveDateFrom,
 		MoveDateTo:   moveDateTo,
+		Search:       filter.Search,
 	}
 }
 
@@ -123,6 +149,16 @@ func (s *Handlers) Orders(ctx context.Context, filter *Filter) ([]*Order, error)
 			MoveFrom:       order.MoveFrom,
 			MoveTo:         order.MoveTo,
 			AdditionalInfo: order.AdditionalInfo,
+			ReviewSecret:   order.ReviewSecret,
+			Source:         LeadSource(order.Source),
+			UTMSource:      order.UTMSource,
+			UTMMedium:      order.UTMMedium,
+			UTMCampaign:    order.UTMCampaign,
+			Referrer:       order.Referrer,
+			LandingPage:    order.LandingPage,
+			SpamReason:     order.SpamReason,
+			ArrivalWindow:  ArrivalWindow(order.ArrivalWindow),
+			UpdatedBy:      order.UpdatedBy,
 			CreatedAt:      order.CreatedAt,
 			UpdatedAt:      order.UpdatedAt,
 		})
@@ -156,6 +192,16 @@ func (s *Handlers) OrderByID(ctx context.Context, id uint64) (*Order, error) {
 		MoveFrom:       order.MoveFrom,
 		MoveTo:         order.MoveTo,
 		AdditionalInfo: order.AdditionalInfo,
+		ReviewSecret:   order.ReviewSecret,
+		Source:         LeadSource(order.Source),
+		UTMSource:      order.UTMSource,
+		UTMMedium:      order.UTMMedium,
+		UTMCampaign:    order.UTMCampaign,
+		Referrer:       order.Referrer,
+		LandingPage:    order.LandingPage,
+		SpamReason:     order.SpamReason,
+		ArrivalWindow:  ArrivalWindow(order.ArrivalWindow),
+		UpdatedBy:      order.UpdatedBy,
 		CreatedAt:      order.CreatedAt,
 		UpdatedAt:      order.UpdatedAt,
 	}, nil
@@ -181,13 +227,60 @@ func (s *Handlers) UpdateOrder(ctx context.Context, req *UpdateOrderRequest) err
 		svcReq.OrderStatus = utils.PtrIfNotZero(orderssvc.OrderStatus(*req.OrderStatus))
 	}
 
+	if req.ArrivalWindow != nil {
+		svcReq.ArrivalWindow = utils.PtrIfNotZero(orderssvc.ArrivalWindow(*req.ArrivalWindow))
+	}
+
 	if err := s.OrdersService.UpdateOrder(ctx, svcReq); err != nil {
+		if errors.Is(err, orderssvc.ErrNotFound) {
+			return ErrNotFound
+		}
+
 		return fmt.Errorf("failed update order | %w", err)
 	}
 
 	return nil
 }
 
+func (s *Handlers) OrdersStats(ctx context.Context, filter *Filter, granularity StatsGranularity) (*Stats, error) {
+	svcStats, err := s.OrdersService.OrdersStats(ctx, normalizeFilter(filter), orderssvc.StatsGranularity(granularity))
+	if err != nil {
+		return nil, fmt.Errorf("failed get orders stats | %w", err)
+	}
+
+	stats := &Stats{
+		Total:          svcStats.Total,
+		ByOrderStatus:  make(map[OrderStatus]uint64, len(svcStats.ByOrderStatus)),
+		ByPropertySize: make(map[PropertySize]uint64, len(svcStats.ByPropertySize)),
+		BySource:       make(map[LeadSource]uint64, len(svcStats.BySource)),
+		ByCreatedAt:    make([]PeriodCount, 0, len(svcStats.ByCreatedAt)),
+		ByMoveDate:     make([]PeriodCount, 0, len(svcStats.ByMoveDate)),
+		ConversionRate: svcStats.ConversionRate,
+	}
+
+	for status, count := range svcStats.ByOrderStatus {
+		stats.ByOrderStatus[OrderStatus(status)] = count
+	}
+
+	for propertySize, count := range svcStats.ByPropertySize {
+		stats.ByPropertySize[PropertySize(propertySize)] = count
+	}
+
+	for source, count := range svcStats.BySource {
+		stats.BySource[LeadSource(source)] = count
+	}
+
+	for _, count := range svcStats.ByCreatedAt {
+		stats.ByCreatedAt = append(stats.ByCreatedAt, PeriodCount(count))
+	}
+
+	for _, count := range svcStats.ByMoveDate {
+		stats.ByMoveDate = append(stats.ByMoveDate, PeriodCount(count))
+	}
+
+	return stats, nil
+}
+
 type PropertySize int8
 
 const (
@@ -200,6 +293,28 @@ const (
 	PropertySizeCommercial
 )
 
+type LeadSource int8
+
+const (
+	LeadSourceUnknown LeadSource = iota
+	LeadSourceWebsite
+	LeadSourceYelp
+	LeadSourceGoogleAds
+	LeadSourceFacebookAds
+	LeadSourceReferral
+	LeadSourcePhone
+	LeadSourceOther
+)
+
+type ArrivalWindow int8
+
+const (
+	ArrivalWindowUnknown ArrivalWindow = iota
+	ArrivalWindowMorning
+	ArrivalWindowMidday
+	ArrivalWindowAfternoon
+)
+
 type OrderStatus int8
 
 const (
@@ -214,11 +329,18 @@ type CreateOrderRequest struct {
 	PropertySize   PropertySize
 	MoveDate       time.Time
 	Name           string
-	Email          *string
+	Email          *string `validate:"omitempty,email,max=255"`
 	Phone          string
 	MoveFrom       string
 	MoveTo         string
 	AdditionalInfo *string
+	Source         LeadSource
+	UTMSource      *string `validate:"omitempty,max=255"`
+	UTMMedium      *string `validate:"omitempty,max=255"`
+	UTMCampaign    *string `validate:"omitempty,max=255"`
+	Referrer       *string `validate:"omitempty,max=2048"`
+	LandingPage    *string `validate:"omitempty,max=2048"`
+	SpamReason     *string
 }
 
 type Order struct {
@@ -232,6 +354,16 @@ type Order struct {
 	MoveFrom       string
 	MoveTo         string
 	AdditionalInfo *string
+	ReviewSecret   string
+	Source         LeadSource
+	UTMSource      *string
+	UTMMedium      *string
+	UTMCampaign    *string
+	Referrer       *string
+	LandingPage    *string
+	SpamReason     *string
+	ArrivalWindow  ArrivalWindow
+	UpdatedBy      *uint64
 	CreatedAt      time.Time
 	UpdatedAt      time.Time
 }
@@ -247,13 +379,41 @@ type UpdateOrderRequest struct {
 	MoveFrom       *string
 	MoveTo         *string
 	AdditionalInfo *string
+	ArrivalWindow  *ArrivalWindow
 }
 
 type Filter struct {
 	OrderStatus  *OrderStatus
 	PropertySize *PropertySize
+	Source       *LeadSource
+	Spam         bool
 	CreatedFrom  *time.Time
 	CreatedTo    *time.Time
 	MoveDateFrom *time.Time
 	MoveDateTo   *time.Time
+	Search       *string
+}
+
+type StatsGranularity int8
+
+const (
+	StatsGranularityUnknown StatsGranularity = iota
+	StatsGranularityDay
+	StatsGranularityWeek
+	StatsGranularityMonth
+)
+
+type Stats struct {
+	Total          uint64
+	ByOrderStatus  map[OrderStatus]uint64
+	ByPropertySize map[PropertySize]uint64
+	BySource       map[LeadSource]uint64
+	ByCreatedAt    []PeriodCount
+	ByMoveDate     []PeriodCount
+	ConversionRate float64
+}
+
+type PeriodCount struct {
+	PeriodStart time.Time
+	Count       uint64
 }
@@ -0,0 +1,73 @@
+package orders
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	orderssvc "github.com/ingvarmattis/moving/src/services/orders"
+)
+
+var ErrWatchInterrupted = errors.New("orders watch interrupted, watch again to resync")
+
+type OrderChangeType int8
+
+const (
+	OrderChangeTypeUnknown OrderChangeType = iota
+	OrderChangeTypeSnapshot
+	OrderChangeTypeSynced
+	OrderChangeTypeCreated
+	OrderChangeTypeUpdated
+	OrderChangeTypeRemoved
+)
+
+// OrderChange Order is nil for OrderChangeTypeSynced.
+type OrderChange struct {
+	Type  OrderChangeType
+	Order *Order
+}
+
+func (s *Handlers) WatchOrders(ctx context.Context, filter *Filter, send func(change *OrderChange) error) error {
+	if err := s.OrdersService.WatchOrders(ctx, normalizeFilter(filter), func(change *orderssvc.OrderChange) error {
+		return send(&OrderChange{Type: OrderChangeType(change.Type), Order: toOrder(change.Order)})
+	}); err != nil {
+		if errors.Is(err, orderssvc.ErrWatchInterrupted) {
+			return ErrWatchInterrupted
+		}
+
+		return fmt.Errorf("failed watch orders | %w", err)
+	}
+
+	return nil
+}
+
+func toOrder(order *orderssvc.Order) *Order {
+	if order == nil {
+		return nil
+	}
+
+	return &Order{
+		ID:             order.ID,
+		PropertySize:   PropertySize(order.PropertySize),
+		OrderStatus:    OrderStatus(order.OrderStatus),
+		MoveDate:       order.MoveDate,
+		Name:           order.Name,
+		Email:          order.Email,
+		Phone:          order.Phone,
+		MoveFrom:       order.MoveFrom,
+		MoveTo:         order.MoveTo,
+		AdditionalInfo: order.AdditionalInfo,
+		ReviewSecret:   order.ReviewSecret,
+		Source:         LeadSource(order.Source),
+		UTMSource:      order.UTMSource,
+		UTMMedium:      order.UTMMedium,
+		UTMCampaign:    order.UTMCampaign,
+		Referrer:       order.Referrer,
+		LandingPage:    order.LandingPage,
+		SpamReason:     order.SpamReason,
+		ArrivalWindow:  ArrivalWindow(order.ArrivalWindow),
+		UpdatedBy:      order.UpdatedBy,
+		CreatedAt:      order.CreatedAt,
+		UpdatedAt:      order.UpdatedAt,
+	}
+}
@@ -9,7 +9,12 @@ import (
 	reviewssvc "github.com/ingvarmattis/moving/src/services/reviews"
 )
 
-var ErrNotFound = errors.New("not found")
+var (
+	ErrNotFound      = errors.New("not found")
+	ErrAlreadyExists = errors.New("already exists")
+	ErrInvalidSecret = errors.New("invalid secret")
+	ErrOrderNotDone  = errors.New("order not done")
+)
 
 type Handlers struct {
 	ReviewsService services.ReviewsService
@@ -25,25 +30,108 @@ func (s *Handlers) Reviews(ctx context.Context) ([]Review, error) {
 		return nil, fmt.Errorf("failed get reviews | %w", err)
 	}
 
+	return toReviews(svcReviews), nil
+}
+
+func (s *Handlers) PendingReviews(ctx context.Context) ([]Review, error) {
+	svcReviews, err := s.ReviewsService.PendingReviews(ctx)
+	if err != nil {
+		if errors.Is(err, reviewssvc.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+
+		return nil, fmt.Errorf("failed get pending reviews | %w", err)
+	}
+
+	return toReviews(svcReviews), nil
+}
+
+func (s *Handlers) SubmitReview(ctx context.Context, req *SubmitReviewRequest) (*Review, error) {
+	svcReview, err := s.ReviewsService.SubmitReview(ctx, &reviewssvc.SubmitReviewRequest{
+		OrderID: req.OrderID,
+		Secret:  req.Secret,
+		Name:    req.Name,
+		Rate:    req.Rate,
+		Text:    req.Text,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, reviewssvc.ErrInvalidSecret):
+			return nil, ErrInvalidSecret
+		case errors.Is(err, reviewssvc.ErrOrderNotDone):
+			return nil, ErrOrderNotDone
+		case errors.Is(err, reviewssvc.ErrAlreadyExists):
+			return nil, ErrAlreadyExists
+		}
+
+		return nil, fmt.Errorf("failed submit review | %w", err)
+	}
+
+	review := toReview(*svcReview)
+
+	return &review, nil
+}
+
+func (s *Handlers) ApproveReview(ctx context.Context, id uint64) error {
+	if err := s.ReviewsService.ApproveReview(ctx, id); err != nil {
+		if errors.Is(err, reviewssvc.ErrNotFound) {
+			return ErrNotFound
+		}
+
+		return fmt.Errorf("failed approve review | %w", err)
+	}
+
+	return nil
+}
+
+func (s *Handlers) RejectReview(ctx context.Context, id uint64) error {
+	if err := s.ReviewsService.RejectReview(ctx, id); err != nil {
+		if errors.Is(err, reviewssvc.ErrNotFound) {
+			return ErrNotFound
+		}
+
+		return fmt.Errorf("failed reject review | %w", err)
+	}
+
+	return nil
+}
+
+func toReviews(svcReviews []reviewssvc.Review) []Review {
 	reviews := make([]Review, 0, len(svcReviews))
 
 	for _, svcReview := range svcReviews {
-		reviews = append(reviews, Review{
-			Text:     svcReview.Text,
-			Name:     svcReview.Name,
-			PhotoURL: svcReview.PhotoURL,
-			URL:      svcReview.URL,
-			Rate:     svcReview.Rate,
-		})
+		reviews = append(reviews, toReview(svcReview))
 	}
 
-	return reviews, nil
+	return reviews
+}
+
+func toReview(svcReview reviewssvc.Review) Review {
+	return Review{
+		ID:       svcReview.ID,
+		Text:     svcReview.Text,
+		Name:     svcReview.Name,
+		PhotoURL: svcReview.PhotoURL,
+		URL:      svcReview.URL,
+		Rate:     svcReview.Rate,
+		Source:   svcReview.Source,
+	}
 }
 
 type Review struct {
+	ID       uint64
 	Text     string
 	Name     string
 	PhotoURL string
 	URL      string
 	Rate     int32
+	Source   string
+}
+
+type SubmitReviewRequest struct {
+	OrderID uint64 `validate:"required"`
+	Secret  string `validate:"required"`
+	Name    string `validate:"required,max=100"`
+	Rate    int32  `validate:"min=1,max=5"`
+	Text    string `validate:"required,max=5000"`
 }
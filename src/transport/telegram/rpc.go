@@ -0,0 +1,171 @@
+package telegram
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ingvarmattis/moving/src/services"
+	telegramsvc "github.com/ingvarmattis/moving/src/services/telegram"
+)
+
+const (
+	EventNewOrders     = telegramsvc.EventNewOrders
+	EventStatusChanges = telegramsvc.EventStatusChanges
+	EventDigests       = telegramsvc.EventDigests
+)
+
+var (
+	ErrNotFound      = errors.New("not found")
+	ErrInvalidInvite = errors.New("invalid invite")
+)
+
+type Handlers struct {
+	TelegramService services.TelegramService
+}
+
+func (s *Handlers) CreateInvite(ctx context.Context) (*Invite, error) {
+	invite, err := s.TelegramService.CreateInvite(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed create telegram invite | %w", err)
+	}
+
+	return &Invite{Code: invite.Code, ExpiresAt: invite.ExpiresAt}, nil
+}
+
+func (s *Handlers) Subscribe(ctx context.Context, code string, chatID int64, title string) (*Subscription, error) {
+	subscription, err := s.TelegramService.Subscribe(ctx, code, chatID, title)
+	if err != nil {
+		if errors.Is(err, telegramsvc.ErrInvalidInvite) {
+			return nil, ErrInvalidInvite
+		}
+
+		return nil, fmt.Errorf("failed subscribe telegram chat | %w", err)
+	}
+
+	result := toSubscription(*subscription)
+
+	return &result, nil
+}
+
+func (s *Handlers) Bootstrap(ctx context.Context, chatIDs []int64) ([]int64, error) {
+	revoked, err := s.TelegramService.Bootstrap(ctx, chatIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed bootstrap telegram chats | %w", err)
+	}
+
+	return revoked, nil
+}
+
+func (s *Handlers) Subscription(ctx context.Context, chatID int64) (*Subscription, error) {
+	subscription, err := s.TelegramService.Subscription(ctx, chatID)
+	if err != nil {
+		if errors.Is(err, telegramsvc.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+
+		return nil, fmt.Errorf("failed get telegram subscription | %w", err)
+	}
+
+	result := toSubscription(*subscription)
+
+	return &result, nil
+}
+
+func (s *Handlers) Subscriptions(ctx context.Context) ([]Subscription, error) {
+	subscriptions, err := s.TelegramService.Subscriptions(ctx)
+	if err != nil {
+		if errors.Is(err, telegramsvc.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+
+		return nil, fmt.Errorf("failed get telegram subscriptions | %w", err)
+	}
+
+	result := make([]Subscription, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		result = append(result, toSubscription(subscription))
+	}
+
+	return result, nil
+}
+
+func (s *Handlers) ChatIDs(ctx context.Context, event string) ([]int64, error) {
+	chatIDs, err := s.TelegramService.ChatIDs(ctx, event)
+	if err != nil {
+		return nil, fmt.Errorf("failed get telegram chats | %w", err)
+	}
+
+	return chatIDs, nil
+}
+
+func (s *Handlers) UpdatePreferences(ctx context.Context, chatID int64, preferences *Preferences) error {
+	if err := s.TelegramService.UpdatePreferences(ctx, chatID, &telegramsvc.Preferences{
+		NewOrders:     preferences.NewOrders,
+		StatusChanges: preferences.StatusChanges,
+		Digests:       preferences.Digests,
+	}); err != nil {
+		if errors.Is(err, telegramsvc.ErrNotFound) {
+			return ErrNotFound
+		}
+
+		return fmt.Errorf("failed update telegram preferences | %w", err)
+	}
+
+	return nil
+}
+
+func (s *Handlers) Revoke(ctx context.Context, chatID int64) error {
+	if err := s.TelegramService.Revoke(ctx, chatID); err != nil {
+		if errors.Is(err, telegramsvc.ErrNotFound) {
+			return ErrNotFound
+		}
+
+		return fmt.Errorf("failed revoke telegram chat | %w", err)
+	}
+
+	return nil
+}
+
+func (s *Handlers) ClaimDigest(ctx context.Context, digest string, scheduledAt time.Time) (bool, error) {
+	claimed, err := s.TelegramService.ClaimDigest(ctx, digest, scheduledAt)
+	if err != nil {
+		return false, fmt.Errorf("failed claim telegram digest | %w", err)
+	}
+
+	return claimed, nil
+}
+
+func toSubscription(subscription telegramsvc.Subscription) Subscription {
+	return Subscription{
+		ChatID:   subscription.ChatID,
+		TenantID: subscription.TenantID,
+		Title:    subscription.Title,
+		Preferences: Preferences{
+			NewOrders:     subscription.Preferences.NewOrders,
+			StatusChanges: subscription.Preferences.StatusChanges,
+			Digests:       subscription.Preferences.Digests,
+		},
+		CreatedAt: subscription.CreatedAt,
+	}
+}
+
+type Invite struct {
+	Code      string
+	ExpiresAt time.Time
+}
+
+type Preferences struct {
+	NewOrders     bool
+	StatusChanges bool
+	Digests       bool
+}
+
+type Subscription struct {
+	ChatID      int64
+	TenantID    uint64
+	Title       string
+	Preferences Preferences
+	CreatedAt   time.Time
+}
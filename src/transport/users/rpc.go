@@ -0,0 +1,177 @@
+package users
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ingvarmattis/moving/src/infra/identity"
+	"github.com/ingvarmattis/moving/src/services"
+	userssvc "github.com/ingvarmattis/moving/src/services/users"
+)
+
+var (
+	ErrNotFound            = errors.New("not found")
+	ErrAlreadyExists       = errors.New("already exists")
+	ErrInvalidCredentials  = errors.New("invalid credentials")
+	ErrInvalidRefreshToken = errors.New("invalid refresh token")
+	ErrLoginDisabled       = errors.New("login is disabled")
+)
+
+type Handlers struct {
+	UsersService services.UsersService
+}
+
+func (s *Handlers) Login(ctx context.Context, req *LoginRequest) (*Session, error) {
+	session, err := s.UsersService.Login(ctx, req.Email, req.Password)
+	if err != nil {
+		if errors.Is(err, userssvc.ErrInvalidCredentials) {
+			return nil, ErrInvalidCredentials
+		}
+
+		if errors.Is(err, userssvc.ErrLoginDisabled) {
+			return nil, ErrLoginDisabled
+		}
+
+		return nil, fmt.Errorf("failed login | %w", err)
+	}
+
+	return toSession(session), nil
+}
+
+func (s *Handlers) Refresh(ctx context.Context, refreshToken string) (*Session, error) {
+	session, err := s.UsersService.Refresh(ctx, refreshToken)
+	if err != nil {
+		if errors.Is(err, userssvc.ErrInvalidRefreshToken) {
+			return nil, ErrInvalidRefreshToken
+		}
+
+		if errors.Is(err, userssvc.ErrLoginDisabled) {
+			return nil, ErrLoginDisabled
+		}
+
+		return nil, fmt.Errorf("failed refresh session | %w", err)
+	}
+
+	return toSession(session), nil
+}
+
+func (s *Handlers) Logout(ctx context.Context, refreshToken string) error {
+	if err := s.UsersService.Logout(ctx, refreshToken); err != nil {
+		return fmt.Errorf("failed logout | %w", err)
+	}
+
+	return nil
+}
+
+func (s *Handlers) CreateUser(ctx context.Context, req *CreateUserRequest) (*User, error) {
+	roles := make([]identity.Role, 0, len(req.Roles))
+	for _, role := range req.Roles {
+		roles = append(roles, identity.Role(role))
+	}
+
+	user, err := s.UsersService.CreateUser(ctx, &userssvc.CreateUserRequest{
+		Email:    req.Email,
+		Name:     req.Name,
+		Password: req.Password,
+		Roles:    roles,
+	})
+	if err != nil {
+		if errors.Is(err, userssvc.ErrAlreadyExists) {
+			return nil, ErrAlreadyExists
+		}
+
+		return nil, fmt.Errorf("failed create user | %w", err)
+	}
+
+	result := toUser(*user)
+
+	return &result, nil
+}
+
+func (s *Handlers) Users(ctx context.Context) ([]User, error) {
+	users, err := s.UsersService.Users(ctx)
+	if err != nil {
+		if errors.Is(err, userssvc.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+
+		return nil, fmt.Errorf("failed get users | %w", err)
+	}
+
+	result := make([]User, 0, len(users))
+	for _, user := range users {
+		result = append(result, toUser(user))
+	}
+
+	return result, nil
+}
+
+func (s *Handlers) DisableUser(ctx context.Context, id uint64) error {
+	if err := s.UsersService.DisableUser(ctx, id); err != nil {
+		if errors.Is(err, userssvc.ErrNotFound) {
+			return ErrNotFound
+		}
+
+		return fmt.Errorf("failed disable user | %w", err)
+	}
+
+	return nil
+}
+
+func toSession(session *userssvc.Session) *Session {
+	return &Session{
+		AccessToken:      session.AccessToken,
+		AccessExpiresAt:  session.AccessExpiresAt,
+		RefreshToken:     session.RefreshToken,
+		RefreshExpiresAt: session.RefreshExpiresAt,
+		User:             toUser(session.User),
+	}
+}
+
+func toUser(user userssvc.User) User {
+	roles := make([]string, 0, len(user.Roles))
+	for _, role := range user.Roles {
+		roles = append(roles, string(role))
+	}
+
+	return User{
+		ID:        user.ID,
+		Email:     user.Email,
+		Name:      user.Name,
+		Roles:     roles,
+		CreatedAt: user.CreatedAt,
+		Disabled:  user.Disabled,
+	}
+}
+
+type LoginRequest struct {
+	Email    string `validate:"required,max=255"`
+	Password string `validate:"required,max=72"`
+}
+
+// CreateUserRequest Password is limited to 72 bytes, longer passwords are not supported by bcrypt.
+type CreateUserRequest struct {
+	Email    string   `validate:"required,email,max=255"`
+	Name     string   `validate:"max=255"`
+	Password string   `validate:"required,min=12,max=72"`
+	Roles    []string `validate:"required,min=1,unique,dive,oneof=dispatcher manager owner"`
+}
+
+type User struct {
+	ID        uint64
+	Email     string
+	Name      string
+	Roles     []string
+	CreatedAt time.Time
+	Disabled  bool
+}
+
+type Session struct {
+	AccessToken      string
+	AccessExpiresAt  time.Time
+	RefreshToken     string
+	RefreshExpiresAt time.Time
+	User             User
+}
@@ -3,10 +3,13 @@ package validator
 import (
 	"net"
 	"net/mail"
+	"slices"
 	"strings"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/nyaruka/phonenumbers"
+
+	"github.com/ingvarmattis/moving/src/infra/identity"
 )
 
 func validateServiceName(fl validator.FieldLevel) bool {
@@ -53,3 +56,7 @@ func validateAddress(fl validator.FieldLevel) bool {
 
 	return len(address) <= maxAddressLength
 }
+
+func validateScope(fl validator.FieldLevel) bool {
+	return slices.Contains(identity.Scopes, identity.Scope(fl.Field().String()))
+}
@@ -13,6 +13,10 @@ func NewValidator() (*validator.Validate, error) {
 		return nil, fmt.Errorf("error while register validation `serviceName` | %w", err)
 	}
 
+	if err := validate.RegisterValidation("scope", validateScope); err != nil {
+		return nil, fmt.Errorf("error while register validation `scope` | %w", err)
+	}
+
 	return validate, nil
 }
 
@@ -0,0 +1,146 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ingvarmattis/moving/src/services"
+	webhookssvc "github.com/ingvarmattis/moving/src/services/webhooks"
+)
+
+const (
+	DeliveryStatusPending   = webhookssvc.DeliveryStatusPending
+	DeliveryStatusDelivered = webhookssvc.DeliveryStatusDelivered
+	DeliveryStatusFailed    = webhookssvc.DeliveryStatusFailed
+)
+
+var ErrNotFound = errors.New("not found")
+
+type Handlers struct {
+	WebhooksService services.WebhooksService
+}
+
+func (s *Handlers) CreateWebhook(ctx context.Context, req *CreateWebhookRequest) (*Subscription, error) {
+	subscription, err := s.WebhooksService.CreateWebhook(ctx, &webhookssvc.CreateWebhookRequest{
+		URL:        req.URL,
+		EventTypes: req.EventTypes,
+		Secret:     req.Secret,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed create webhook | %w", err)
+	}
+
+	result := toSubscription(*subscription)
+
+	return &result, nil
+}
+
+func (s *Handlers) Webhooks(ctx context.Context) ([]Subscription, error) {
+	subscriptions, err := s.WebhooksService.Webhooks(ctx)
+	if err != nil {
+		if errors.Is(err, webhookssvc.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+
+		return nil, fmt.Errorf("failed get webhooks | %w", err)
+	}
+
+	result := make([]Subscription, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		result = append(result, toSubscription(subscription))
+	}
+
+	return result, nil
+}
+
+func (s *Handlers) DeleteWebhook(ctx context.Context, id uint64) error {
+	if err := s.WebhooksService.DeleteWebhook(ctx, id); err != nil {
+		if errors.Is(err, webhookssvc.ErrNotFound) {
+			return ErrNotFound
+		}
+
+		return fmt.Errorf("failed delete webhook | %w", err)
+	}
+
+	return nil
+}
+
+func (s *Handlers) WebhookDeliveries(ctx context.Context, filter *DeliveriesFilter) ([]Delivery, error) {
+	deliveries, err := s.WebhooksService.WebhookDeliveries(ctx, &webhookssvc.DeliveriesFilter{
+		SubscriptionID: filter.SubscriptionID,
+		Status:         filter.Status,
+		Limit:          filter.Limit,
+	})
+	if err != nil {
+		if errors.Is(err, webhookssvc.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+
+		return nil, fmt.Errorf("failed get webhook deliveries | %w", err)
+	}
+
+	result := make([]Delivery, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		result = append(result, Delivery{
+			ID:             delivery.ID,
+			SubscriptionID: delivery.SubscriptionID,
+			EventType:      delivery.EventType,
+			OrderID:        delivery.OrderID,
+			Status:         delivery.Status,
+			Attempts:       delivery.Attempts,
+			ResponseStatus: delivery.ResponseStatus,
+			LastError:      delivery.LastError,
+			NextAttemptAt:  delivery.NextAttemptAt,
+			CreatedAt:      delivery.CreatedAt,
+			DeliveredAt:    delivery.DeliveredAt,
+		})
+	}
+
+	return result, nil
+}
+
+func toSubscription(subscription webhookssvc.Subscription) Subscription {
+	return Subscription{
+		ID:         subscription.ID,
+		URL:        subscription.URL,
+		EventTypes: subscription.EventTypes,
+		Secret:     subscription.Secret,
+		CreatedAt:  subscription.CreatedAt,
+	}
+}
+
+type Subscription struct {
+	ID         uint64
+	URL        string
+	EventTypes []string
+	Secret     *string
+	CreatedAt  time.Time
+}
+
+type CreateWebhookRequest struct {
+	URL        string   `validate:"required,http_url,max=2048"`
+	EventTypes []string `validate:"required,min=1,unique,dive,oneof=order.created order.updated order.status_changed"`
+	Secret     *string  `validate:"omitempty,min=16,max=100"`
+}
+
+type Delivery struct {
+	ID             uint64
+	SubscriptionID uint64
+	EventType      string
+	OrderID        uint64
+	Status         string
+	Attempts       int
+	ResponseStatus *int
+	LastError      *string
+	NextAttemptAt  time.Time
+	CreatedAt      time.Time
+	DeliveredAt    *time.Time
+}
+
+type DeliveriesFilter struct {
+	SubscriptionID *uint64
+	Status         *string `validate:"omitempty,oneof=pending delivered failed"`
+	Limit          uint32  `validate:"max=500"`
+}